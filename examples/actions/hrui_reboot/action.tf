@@ -0,0 +1,17 @@
+action "hrui_reboot" "example" {
+  config {
+    ready_timeout = "10m"
+  }
+}
+
+# Reboot the switch after the jumbo frame size changed.
+resource "hrui_jumbo_frame" "example" {
+  size = 9216
+
+  lifecycle {
+    action_trigger {
+      events  = [after_update]
+      actions = [action.hrui_reboot.example]
+    }
+  }
+}
@@ -0,0 +1,6 @@
+data "hrui_config_backup" "switch" {}
+
+resource "local_sensitive_file" "backup" {
+  filename       = "backups/switch-${data.hrui_config_backup.switch.sha256}.bin"
+  content_base64 = data.hrui_config_backup.switch.content_base64
+}
@@ -0,0 +1,2 @@
+# The admin account is a singleton — any import ID works
+terraform import hrui_admin_account.main placeholder
@@ -0,0 +1,5 @@
+resource "hrui_admin_account" "example" {
+  username            = "admin"
+  password_wo         = var.switch_password
+  password_wo_version = 2 # Increment to apply a new password
+}
@@ -0,0 +1,3 @@
+resource "hrui_config_restore" "example" {
+  content_base64 = filebase64("backups/switch.bin")
+}
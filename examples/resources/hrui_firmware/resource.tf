@@ -0,0 +1,5 @@
+resource "hrui_firmware" "example" {
+  image_path     = "${path.module}/firmware/SWTG124AS_V1.9.1.bin"
+  version        = "V1.9.1"
+  reboot_timeout = "10m"
+}
@@ -1,6 +1,7 @@
-# Enable DHCP on the switch
+# Enable DHCP on the switch, which gets the address reserved for it by the DHCP server
 resource "hrui_ip_address_settings" "dhcp" {
   dhcp_enabled = true
+  ip_address   = "192.168.1.100"
 }
 
 # Disable DHCP and set static IP address settings
@@ -10,3 +11,24 @@ resource "hrui_ip_address_settings" "static" {
   netmask      = "255.255.255.0"
   gateway      = "192.168.1.1"
 }
+
+# Static IP address settings in CIDR notation
+resource "hrui_ip_address_settings" "cidr" {
+  dhcp_enabled = false
+  cidr         = "192.168.1.100/24"
+  gateway      = "192.168.1.1"
+}
+
+# Static IPv6 management address, on firmware that supports IPv6 (1.9 and 1.9.1 are not known to)
+resource "hrui_ip_address_settings" "dual_stack" {
+  dhcp_enabled = false
+  ip_address   = "192.168.1.100"
+  netmask      = "255.255.255.0"
+  gateway      = "192.168.1.1"
+
+  ipv6 = {
+    address       = "2001:db8:10::100"
+    prefix_length = 64
+    gateway       = "fe80::1"
+  }
+}
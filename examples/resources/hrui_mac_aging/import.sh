@@ -0,0 +1,2 @@
+# The MAC aging time is a singleton — any import ID works
+terraform import hrui_mac_aging.main placeholder
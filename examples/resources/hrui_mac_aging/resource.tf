@@ -0,0 +1,4 @@
+# Forget roaming Wi-Fi clients quickly after they move to another access point
+resource "hrui_mac_aging" "example" {
+  aging_time = 60
+}
@@ -0,0 +1,2 @@
+# The management settings are a singleton — any import ID works
+terraform import hrui_management_settings.main placeholder
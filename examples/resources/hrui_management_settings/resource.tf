@@ -0,0 +1,5 @@
+# VLAN 99 must already exist on the switch with Port 8 as a member.
+resource "hrui_management_settings" "example" {
+  vlan_id     = 99
+  uplink_port = "Port 8"
+}
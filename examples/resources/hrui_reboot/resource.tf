@@ -0,0 +1,11 @@
+# Reboot the switch whenever the jumbo frame size changes.
+resource "hrui_jumbo_frame" "example" {
+  size = 9216
+}
+
+resource "hrui_reboot" "example" {
+  triggers = {
+    jumbo_frame_size = hrui_jumbo_frame.example.size
+  }
+  ready_timeout = "10m"
+}
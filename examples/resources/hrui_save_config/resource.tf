@@ -0,0 +1,19 @@
+provider "hrui" {
+  autosave_mode = "batch"
+}
+
+resource "hrui_eee" "example" {
+  enabled = false
+}
+
+resource "hrui_jumbo_frame" "example" {
+  size = 9216
+}
+
+# Write all changes to flash once, after the other resources were applied
+resource "hrui_save_config" "example" {
+  depends_on = [
+    hrui_eee.example,
+    hrui_jumbo_frame.example,
+  ]
+}
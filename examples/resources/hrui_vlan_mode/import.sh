@@ -0,0 +1,2 @@
+# The VLAN mode is a singleton — any import ID works
+terraform import hrui_vlan_mode.main placeholder
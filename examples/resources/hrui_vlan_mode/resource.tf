@@ -0,0 +1,3 @@
+resource "hrui_vlan_mode" "example" {
+  mode = "port_based"
+}
@@ -0,0 +1,2 @@
+# Import using the numeric port-based VLAN ID
+terraform import hrui_vlan_port_based.example 1
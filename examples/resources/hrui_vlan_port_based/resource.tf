@@ -0,0 +1,6 @@
+# Requires the switch to be in port-based VLAN mode, see hrui_vlan_mode.
+# Ports 1-4 can reach each other and the uplink, but not the ports of other groups.
+resource "hrui_vlan_port_based" "office" {
+  vlan_id      = 1
+  member_ports = ["Port 1", "Port 2", "Port 3", "Port 4", "Trunk1"]
+}
@@ -0,0 +1,2 @@
+# The VLAN table is a singleton — any import ID works
+terraform import hrui_vlan_table.main placeholder
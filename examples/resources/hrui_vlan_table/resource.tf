@@ -0,0 +1,21 @@
+resource "hrui_vlan_table" "example" {
+  vlans = [
+    {
+      vlan_id        = 10
+      name           = "office"
+      untagged_ports = ["Port 1", "Port 2"]
+      tagged_ports   = ["Port 8"]
+    },
+    {
+      vlan_id        = 20
+      name           = "voice"
+      untagged_ports = []
+      tagged_ports   = ["Port 2", "Port 8"]
+    },
+  ]
+
+  pvids = {
+    "Port 1" = 10
+    "Port 2" = 10
+  }
+}
@@ -3,20 +3,23 @@ package provider
 import (
 	"context"
 	"fmt"
+	neturl "net/url"
 	"os"
 	"strconv"
+	"time"
 
 	"github.com/brennoo/terraform-provider-hrui/internal/providerutil"
 	"github.com/brennoo/terraform-provider-hrui/internal/sdk"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 // ConfigureClient extracts *sdk.HRUIClient from ProviderData for use in resource/datasource Configure methods.
 // Returns nil without error when ProviderData is nil (framework calls Configure before the provider is ready
 // during planning/validation phases).
 //
-// Deprecated: Use providerutil.ConfigureClient directly. Resources and data sources must not import the provider
+// Deprecated: Use providerutil.ConfigureDevices directly. Resources and data sources must not import the provider
 // package due to the import cycle it creates.
 func ConfigureClient(providerData any, diags *diag.Diagnostics) *sdk.HRUIClient {
 	return providerutil.ConfigureClient(providerData, diags)
@@ -37,22 +40,30 @@ func (p *hruiProvider) Configure(ctx context.Context, req provider.ConfigureRequ
 	username, usernameOk := os.LookupEnv("HRUI_USERNAME")
 	password, passwordOk := os.LookupEnv("HRUI_PASSWORD")
 	autosaveEnv, autosaveEnvOk := os.LookupEnv("HRUI_AUTOSAVE")
+	autosaveModeEnv, autosaveModeEnvOk := os.LookupEnv("HRUI_AUTOSAVE_MODE")
+	maxConcurrentEnv, maxConcurrentEnvOk := os.LookupEnv("HRUI_MAX_CONCURRENT_REQUESTS")
+	requestTimeoutEnv, requestTimeoutEnvOk := os.LookupEnv("HRUI_REQUEST_TIMEOUT")
+	retryMaxAttemptsEnv, retryMaxAttemptsEnvOk := os.LookupEnv("HRUI_RETRY_MAX_ATTEMPTS")
+	retryMaxBackoffEnv, retryMaxBackoffEnvOk := os.LookupEnv("HRUI_RETRY_MAX_BACKOFF")
+	strictValidationEnv, strictValidationEnvOk := os.LookupEnv("HRUI_STRICT_VALIDATION")
 
 	// Determine the correct URL, either from the config or environment variable.
+	// Without a URL, resources must select one of the named devices.
 	if !config.URL.IsNull() {
 		url = config.URL.ValueString()
-	} else if !urlOk {
+	} else if !urlOk && len(config.Devices) == 0 {
 		resp.Diagnostics.AddError(
 			"Missing URL Configuration",
-			"'url' must be provided either in the configuration or via the 'HRUI_URL' environment variable.",
+			"'url' must be provided either in the configuration or via the 'HRUI_URL' environment variable, unless 'devices' is set.",
 		)
 		return
 	}
+	defaultDevice := url != ""
 
 	// Determine the correct username, either from the config or environment variable.
 	if !config.Username.IsNull() {
 		username = config.Username.ValueString()
-	} else if !usernameOk {
+	} else if !usernameOk && defaultDevice {
 		resp.Diagnostics.AddError(
 			"Missing Username Configuration",
 			"'username' must be provided either in the configuration or via the 'HRUI_USERNAME' environment variable.",
@@ -63,7 +74,7 @@ func (p *hruiProvider) Configure(ctx context.Context, req provider.ConfigureRequ
 	// Determine the correct password, either from the config or environment variable.
 	if !config.Password.IsNull() {
 		password = config.Password.ValueString()
-	} else if !passwordOk {
+	} else if !passwordOk && defaultDevice {
 		resp.Diagnostics.AddError(
 			"Missing Password Configuration",
 			"'password' must be provided either in the configuration or via the 'HRUI_PASSWORD' environment variable.",
@@ -87,27 +98,243 @@ func (p *hruiProvider) Configure(ctx context.Context, req provider.ConfigureRequ
 		}
 	}
 
-	// Create a new HRUI client using the resolved configuration and credentials.
-	hruiClient, err := sdk.NewClient(ctx, url, username, password, autosave, p.testHttpClient)
+	// Handle Autosave mode: default to saving after every change, support environment variable override.
+	autosaveMode := sdk.AutosaveImmediate
+	if !config.AutosaveMode.IsNull() {
+		autosaveMode = config.AutosaveMode.ValueString()
+	} else if autosaveModeEnvOk {
+		if autosaveModeEnv != sdk.AutosaveImmediate && autosaveModeEnv != sdk.AutosaveBatch {
+			resp.Diagnostics.AddError(
+				"Invalid HRUI_AUTOSAVE_MODE Environment Variable",
+				fmt.Sprintf("HRUI_AUTOSAVE_MODE must be set to %q or %q, got: %s", sdk.AutosaveImmediate, sdk.AutosaveBatch, autosaveModeEnv),
+			)
+			return
+		}
+		autosaveMode = autosaveModeEnv
+	}
+
+	// Handle request concurrency: default to serialized requests, support environment variable override.
+	maxConcurrentRequests := 1
+	if !config.MaxConcurrentRequests.IsNull() {
+		maxConcurrentRequests = int(config.MaxConcurrentRequests.ValueInt64())
+	} else if maxConcurrentEnvOk {
+		var err error
+		maxConcurrentRequests, err = strconv.Atoi(maxConcurrentEnv)
+		if err != nil || maxConcurrentRequests < 1 {
+			resp.Diagnostics.AddError(
+				"Invalid HRUI_MAX_CONCURRENT_REQUESTS Environment Variable",
+				fmt.Sprintf("HRUI_MAX_CONCURRENT_REQUESTS must be set to a positive integer, got: %s", maxConcurrentEnv),
+			)
+			return
+		}
+	}
+
+	// Handle the request timeout: default to the SDK timeout, support environment variable override.
+	requestTimeout := sdk.DefaultRequestTimeout
+	requestTimeoutValue, requestTimeoutSource := requestTimeoutEnv, "HRUI_REQUEST_TIMEOUT"
+	if !config.RequestTimeout.IsNull() {
+		requestTimeoutValue, requestTimeoutSource = config.RequestTimeout.ValueString(), "request_timeout"
+	}
+	if !config.RequestTimeout.IsNull() || requestTimeoutEnvOk {
+		var err error
+		requestTimeout, err = time.ParseDuration(requestTimeoutValue)
+		if err != nil || requestTimeout < 0 {
+			resp.Diagnostics.AddError(
+				"Invalid Request Timeout Configuration",
+				fmt.Sprintf("%s must be set to a non-negative duration such as \"30s\", got: %s", requestTimeoutSource, requestTimeoutValue),
+			)
+			return
+		}
+	}
+
+	// Handle retries: start from the SDK defaults, support environment variable overrides.
+	retryPolicy := sdk.DefaultRetryPolicy()
+	if !config.RetryMaxAttempts.IsNull() {
+		retryPolicy.MaxAttempts = int(config.RetryMaxAttempts.ValueInt64())
+	} else if retryMaxAttemptsEnvOk {
+		var err error
+		retryPolicy.MaxAttempts, err = strconv.Atoi(retryMaxAttemptsEnv)
+		if err != nil || retryPolicy.MaxAttempts < 1 {
+			resp.Diagnostics.AddError(
+				"Invalid HRUI_RETRY_MAX_ATTEMPTS Environment Variable",
+				fmt.Sprintf("HRUI_RETRY_MAX_ATTEMPTS must be set to a positive integer, got: %s", retryMaxAttemptsEnv),
+			)
+			return
+		}
+	}
+
+	retryMaxBackoff, retryMaxBackoffSource := retryMaxBackoffEnv, "HRUI_RETRY_MAX_BACKOFF"
+	if !config.RetryMaxBackoff.IsNull() {
+		retryMaxBackoff, retryMaxBackoffSource = config.RetryMaxBackoff.ValueString(), "retry_max_backoff"
+	}
+	if !config.RetryMaxBackoff.IsNull() || retryMaxBackoffEnvOk {
+		maxBackoff, err := time.ParseDuration(retryMaxBackoff)
+		if err != nil || maxBackoff < 0 {
+			resp.Diagnostics.AddError(
+				"Invalid Retry Backoff Configuration",
+				fmt.Sprintf("%s must be set to a non-negative duration such as \"5s\", got: %s", retryMaxBackoffSource, retryMaxBackoff),
+			)
+			return
+		}
+		retryPolicy.MaxBackoff = maxBackoff
+		retryPolicy.BaseBackoff = min(retryPolicy.BaseBackoff, maxBackoff)
+	}
+
+	// Handle strict validation: consistency problems are warnings by default, support environment variable override.
+	strictValidation := false
+	if !config.StrictValidation.IsNull() {
+		strictValidation = config.StrictValidation.ValueBool()
+	} else if strictValidationEnvOk {
+		var err error
+		strictValidation, err = strconv.ParseBool(strictValidationEnv)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Invalid HRUI_STRICT_VALIDATION Environment Variable",
+				fmt.Sprintf("HRUI_STRICT_VALIDATION must be set to a valid boolean, got: %s", strictValidationEnv),
+			)
+			return
+		}
+	}
+
+	clientOptions := []sdk.ClientOption{
+		sdk.WithAutosaveMode(autosaveMode),
+		sdk.WithMaxConcurrentRequests(maxConcurrentRequests),
+		sdk.WithRequestTimeout(requestTimeout),
+		sdk.WithRetryPolicy(retryPolicy),
+	}
+
+	// Handle HTTPS: trust additional CAs, optionally skip verification or present a client certificate.
+	tlsOptions := sdk.TLSOptions{
+		CACertPEM:     []byte(resolveString(config.CACertPEM, "HRUI_CA_CERT_PEM")),
+		ClientCertPEM: []byte(resolveString(config.ClientCert, "HRUI_CLIENT_CERT")),
+		ClientKeyPEM:  []byte(resolveString(config.ClientKey, "HRUI_CLIENT_KEY")),
+	}
+	if caCertFile := resolveString(config.CACertFile, "HRUI_CA_CERT_FILE"); caCertFile != "" {
+		caCert, err := os.ReadFile(caCertFile) //#nosec G304 -- path is provided by the operator
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Invalid CA Certificate File",
+				fmt.Sprintf("Unable to read CA certificate file %s: %s", caCertFile, err),
+			)
+			return
+		}
+		tlsOptions.CACertPEM = append(append(tlsOptions.CACertPEM, '\n'), caCert...)
+	}
+	if !config.InsecureSkipVerify.IsNull() {
+		tlsOptions.InsecureSkipVerify = config.InsecureSkipVerify.ValueBool()
+	} else if insecureEnv, ok := os.LookupEnv("HRUI_INSECURE_SKIP_VERIFY"); ok {
+		var err error
+		tlsOptions.InsecureSkipVerify, err = strconv.ParseBool(insecureEnv)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Invalid HRUI_INSECURE_SKIP_VERIFY Environment Variable",
+				fmt.Sprintf("HRUI_INSECURE_SKIP_VERIFY must be set to a valid boolean, got: %s", insecureEnv),
+			)
+			return
+		}
+	}
+	tlsConfig, err := sdk.NewTLSConfig(tlsOptions)
 	if err != nil {
 		resp.Diagnostics.AddError(
-			"Client Creation Error",
-			fmt.Sprintf("Failed to create the HRUI client: %s", err.Error()),
+			"Invalid TLS Configuration",
+			fmt.Sprintf("Unable to configure HTTPS for the HRUI client: %s", err),
 		)
 		return
 	}
+	clientOptions = append(clientOptions, sdk.WithTLSConfig(tlsConfig))
 
-	// Test connectivity with a basic request to validate the client setup.
-	_, err = hruiClient.Request(ctx, "GET", hruiClient.URL, nil, nil)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Connection Error",
-			"Unable to connect to the HRUI API: "+err.Error(),
+	// Handle the proxy: an explicit URL overrides the standard proxy environment variables.
+	if proxyURL := resolveString(config.ProxyURL, "HRUI_PROXY_URL"); proxyURL != "" {
+		parsedProxyURL, err := neturl.Parse(proxyURL)
+		if err != nil || parsedProxyURL.Scheme == "" || parsedProxyURL.Host == "" {
+			resp.Diagnostics.AddError(
+				"Invalid Proxy URL",
+				fmt.Sprintf("proxy_url must be an absolute URL such as http://proxy:3128, got: %s", proxyURL),
+			)
+			return
+		}
+		clientOptions = append(clientOptions, sdk.WithProxyURL(parsedProxyURL))
+	}
+	clientOptions = append(clientOptions, p.clientOptions...)
+
+	// Create a new HRUI client for the default device using the resolved configuration and credentials.
+	var hruiClient *sdk.HRUIClient
+	if defaultDevice {
+		hruiClient, err = p.connect(ctx, url, username, password, autosave, clientOptions)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Connection Error",
+				fmt.Sprintf("Unable to connect to the HRUI API: %s", err),
+			)
+			return
+		}
+	}
+	batchAutosave := autosave && autosaveMode == sdk.AutosaveBatch
+	devices := providerutil.NewDevices(hruiClient)
+	devices.PlanChecks = !p.skipPlanChecks
+	devices.StrictValidation = strictValidation
+
+	// Register the named devices. They inherit the credentials and client settings of the provider
+	// and connect the first time a resource selects them.
+	for name, device := range config.Devices {
+		deviceURL := device.URL.ValueString()
+		deviceUsername, devicePassword, deviceAutosave := username, password, autosave
+		if !device.Username.IsNull() {
+			deviceUsername = device.Username.ValueString()
+		}
+		if !device.Password.IsNull() {
+			devicePassword = device.Password.ValueString()
+		}
+		if !device.Autosave.IsNull() {
+			deviceAutosave = device.Autosave.ValueBool()
+		}
+		batchAutosave = batchAutosave || deviceAutosave && autosaveMode == sdk.AutosaveBatch
+		if deviceUsername == "" || devicePassword == "" {
+			resp.Diagnostics.AddError(
+				"Missing Device Credentials",
+				fmt.Sprintf("Device %q needs a 'username' and 'password', either in its 'devices' entry or at the provider level.", name),
+			)
+			return
+		}
+		devices.Add(name, func(ctx context.Context) (*sdk.HRUIClient, error) {
+			return p.connect(ctx, deviceURL, deviceUsername, devicePassword, deviceAutosave, clientOptions)
+		})
+	}
+
+	// In batch mode nothing saves the changes of an apply unless the configuration has an
+	// hrui_save_config resource ordered after them, so remind the operator on every run.
+	if batchAutosave {
+		resp.Diagnostics.AddWarning(
+			"Changes Are Saved By hrui_save_config Only",
+			"With autosave_mode = \"batch\", changes are written to flash only by an hrui_save_config resource that lists every resource of the switch in depends_on. "+
+				"Changes made without such a resource, or after it, are lost when the switch restarts.",
 		)
-		return
 	}
 
-	// Provide the HRUI client to the data sources and resources.
-	resp.DataSourceData = hruiClient
-	resp.ResourceData = hruiClient
+	// Provide the device inventory to the data sources, resources and actions.
+	resp.DataSourceData = devices
+	resp.ResourceData = devices
+	resp.ActionData = devices
+}
+
+// connect creates a client for the switch at url and checks that it responds.
+func (p *hruiProvider) connect(ctx context.Context, url, username, password string, autosave bool, options []sdk.ClientOption) (*sdk.HRUIClient, error) {
+	hruiClient, err := sdk.NewClient(ctx, url, username, password, autosave, p.testHttpClient, options...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create the HRUI client: %w", err)
+	}
+
+	// Test connectivity with a basic request to validate the client setup.
+	if _, err := hruiClient.Request(ctx, "GET", hruiClient.BaseURL(), nil, nil); err != nil {
+		return nil, err
+	}
+	return hruiClient, nil
+}
+
+// resolveString returns the configured value of attr, falling back to the environment variable envVar.
+func resolveString(attr types.String, envVar string) string {
+	if !attr.IsNull() {
+		return attr.ValueString()
+	}
+	return os.Getenv(envVar)
 }
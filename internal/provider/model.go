@@ -10,4 +10,28 @@ type hruiProviderModel struct {
 	Username types.String `tfsdk:"username"`
 	Password types.String `tfsdk:"password"`
 	Autosave types.Bool   `tfsdk:"autosave"`
+
+	AutosaveMode          types.String `tfsdk:"autosave_mode"`
+	MaxConcurrentRequests types.Int64  `tfsdk:"max_concurrent_requests"`
+	RequestTimeout        types.String `tfsdk:"request_timeout"`
+	RetryMaxAttempts      types.Int64  `tfsdk:"retry_max_attempts"`
+	RetryMaxBackoff       types.String `tfsdk:"retry_max_backoff"`
+	StrictValidation      types.Bool   `tfsdk:"strict_validation"`
+
+	CACertPEM          types.String `tfsdk:"ca_cert_pem"`
+	CACertFile         types.String `tfsdk:"ca_cert_file"`
+	InsecureSkipVerify types.Bool   `tfsdk:"insecure_skip_verify"`
+	ClientCert         types.String `tfsdk:"client_cert"`
+	ClientKey          types.String `tfsdk:"client_key"`
+	ProxyURL           types.String `tfsdk:"proxy_url"`
+
+	Devices map[string]hruiDeviceModel `tfsdk:"devices"`
+}
+
+// hruiDeviceModel describes a named device of the provider `devices` map.
+type hruiDeviceModel struct {
+	URL      types.String `tfsdk:"url"`
+	Username types.String `tfsdk:"username"`
+	Password types.String `tfsdk:"password"`
+	Autosave types.Bool   `tfsdk:"autosave"`
 }
@@ -4,37 +4,52 @@ import (
 	"context"
 	"net/http"
 
+	"github.com/brennoo/terraform-provider-hrui/internal/resources/admin_account"
 	"github.com/brennoo/terraform-provider-hrui/internal/resources/bandwidth_control"
+	"github.com/brennoo/terraform-provider-hrui/internal/resources/config_backup"
+	"github.com/brennoo/terraform-provider-hrui/internal/resources/config_restore"
 	"github.com/brennoo/terraform-provider-hrui/internal/resources/eee"
+	"github.com/brennoo/terraform-provider-hrui/internal/resources/firmware"
 	"github.com/brennoo/terraform-provider-hrui/internal/resources/igmp_snooping"
 	"github.com/brennoo/terraform-provider-hrui/internal/resources/igmp_snooping_static"
 	"github.com/brennoo/terraform-provider-hrui/internal/resources/ip_address_settings"
 	"github.com/brennoo/terraform-provider-hrui/internal/resources/jumbo_frame"
 	"github.com/brennoo/terraform-provider-hrui/internal/resources/loop_protocol"
+	"github.com/brennoo/terraform-provider-hrui/internal/resources/mac_aging"
 	"github.com/brennoo/terraform-provider-hrui/internal/resources/mac_limit"
 	"github.com/brennoo/terraform-provider-hrui/internal/resources/mac_static"
 	"github.com/brennoo/terraform-provider-hrui/internal/resources/mac_table"
+	"github.com/brennoo/terraform-provider-hrui/internal/resources/management_settings"
 	"github.com/brennoo/terraform-provider-hrui/internal/resources/port_isolation"
 	"github.com/brennoo/terraform-provider-hrui/internal/resources/port_mirroring"
 	"github.com/brennoo/terraform-provider-hrui/internal/resources/port_settings"
 	"github.com/brennoo/terraform-provider-hrui/internal/resources/port_statistics"
 	"github.com/brennoo/terraform-provider-hrui/internal/resources/qos_port_queue"
 	"github.com/brennoo/terraform-provider-hrui/internal/resources/qos_queue_weight"
+	"github.com/brennoo/terraform-provider-hrui/internal/resources/reboot"
+	"github.com/brennoo/terraform-provider-hrui/internal/resources/save_config"
 	"github.com/brennoo/terraform-provider-hrui/internal/resources/storm_control"
 	"github.com/brennoo/terraform-provider-hrui/internal/resources/stp_global"
 	"github.com/brennoo/terraform-provider-hrui/internal/resources/stp_port"
 	"github.com/brennoo/terraform-provider-hrui/internal/resources/system_info"
 	"github.com/brennoo/terraform-provider-hrui/internal/resources/trunk_group"
 	"github.com/brennoo/terraform-provider-hrui/internal/resources/vlan_8021q"
+	"github.com/brennoo/terraform-provider-hrui/internal/resources/vlan_mode"
+	"github.com/brennoo/terraform-provider-hrui/internal/resources/vlan_port_based"
+	"github.com/brennoo/terraform-provider-hrui/internal/resources/vlan_table"
 	"github.com/brennoo/terraform-provider-hrui/internal/resources/vlan_vid"
+	"github.com/brennoo/terraform-provider-hrui/internal/sdk"
+	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
-
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
-var _ provider.Provider = &hruiProvider{}
+var (
+	_ provider.Provider            = &hruiProvider{}
+	_ provider.ProviderWithActions = &hruiProvider{}
+)
 
 // hruiProvider defines the provider implementation.
 type hruiProvider struct {
@@ -43,6 +58,10 @@ type hruiProvider struct {
 	version string
 	// testHttpClient is used for injecting a custom HTTP client during testing (e.g., go-vcr).
 	testHttpClient *http.Client
+	// clientOptions are appended to the options derived from the provider configuration.
+	clientOptions []sdk.ClientOption
+	// skipPlanChecks disables the plan-time checks that read the switch.
+	skipPlanChecks bool
 }
 
 // New is a helper function to simplify provider server and testing logic.
@@ -56,10 +75,19 @@ func New(version string) func() provider.Provider {
 
 // NewForTest creates a provider instance with a custom HTTP client for testing.
 // This allows injection of go-vcr clients for acceptance testing.
+// Cassettes replay interactions in the order they were recorded, so the page cache, firmware
+// detection and following IP address changes are disabled to issue exactly the requests that were recorded, and so are
+// the plan-time checks, which read pages again at unrecorded points.
 func NewForTest(version string, client *http.Client) provider.Provider {
 	return &hruiProvider{
 		version:        version,
 		testHttpClient: client,
+		skipPlanChecks: true,
+		clientOptions: []sdk.ClientOption{
+			sdk.WithPageCacheTTL(0),
+			sdk.WithFirmwareDetection(false),
+			sdk.WithAddressChangeFollowing(false),
+		},
 	}
 }
 
@@ -76,6 +104,7 @@ func (p *hruiProvider) DataSources(ctx context.Context) []func() datasource.Data
 		mac_table.NewDataSource,
 		mac_static.NewDataSource,
 		port_statistics.NewDataSource,
+		config_backup.NewDataSource,
 	}
 }
 
@@ -103,5 +132,23 @@ func (p *hruiProvider) Resources(ctx context.Context) []func() resource.Resource
 		jumbo_frame.NewResource,
 		eee.NewResource,
 		mac_limit.NewResource,
+		save_config.NewResource,
+		admin_account.NewResource,
+		config_restore.NewResource,
+		firmware.NewResource,
+		reboot.NewResource,
+		vlan_mode.NewResource,
+		vlan_port_based.NewResource,
+		vlan_table.NewResource,
+		management_settings.NewResource,
+		mac_aging.NewResource,
+	}
+}
+
+// Actions - Defines the provider's actions, i.e. the operations that can be
+// invoked outside of the resource lifecycle.
+func (p *hruiProvider) Actions(ctx context.Context) []func() action.Action {
+	return []func() action.Action{
+		reboot.NewAction,
 	}
 }
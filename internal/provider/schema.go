@@ -3,8 +3,13 @@ package provider
 import (
 	"context"
 
+	"github.com/brennoo/terraform-provider-hrui/internal/sdk"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 )
 
 // Schema defines the provider-level schema for configuration data.
@@ -13,7 +18,7 @@ func (p *hruiProvider) Schema(ctx context.Context, req provider.SchemaRequest, r
 		Description: "The HRUI Terraform Provider allows you to manage network switches made by HRUI (Shenzhen HongRui Optical Technology Co., Ltd) that have a Web UI.",
 		Attributes: map[string]schema.Attribute{
 			"url": schema.StringAttribute{
-				MarkdownDescription: "URL of the HRUI switch web interface. Can also be set using the `HRUI_URL` environment variable.",
+				MarkdownDescription: "URL of the HRUI switch web interface. Can also be set using the `HRUI_URL` environment variable. Optional if `devices` is set; resources without a `device` attribute manage this switch.",
 				Optional:            true,
 			},
 			"username": schema.StringAttribute{
@@ -28,6 +33,95 @@ func (p *hruiProvider) Schema(ctx context.Context, req provider.SchemaRequest, r
 				Optional:            true,
 				MarkdownDescription: "Enable automatic saving of configuration changes after resource creation or updates. Can also be set using the `HRUI_AUTOSAVE` environment variable.",
 			},
+			"autosave_mode": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "When to save changes if `autosave` is enabled. `immediate` (default) saves after every change. `batch` only records that changes were made; they are written to flash once by an `hrui_save_config` resource, which must list every resource of the switch in `depends_on`. Changes that are not saved are lost when the switch restarts. Can also be set using the `HRUI_AUTOSAVE_MODE` environment variable.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(sdk.AutosaveImmediate, sdk.AutosaveBatch),
+				},
+			},
+			"max_concurrent_requests": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Maximum number of requests sent to the switch at the same time. Defaults to `1`, which serializes all requests as the switch web UI is single-threaded. Can also be set using the `HRUI_MAX_CONCURRENT_REQUESTS` environment variable.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"request_timeout": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Timeout for a single request to the switch, as a Go duration string (e.g. `30s`, `1m`). Each retry gets the full timeout. Defaults to `30s`; `0s` disables the timeout. Can also be set using the `HRUI_REQUEST_TIMEOUT` environment variable.",
+			},
+			"retry_max_attempts": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Maximum number of attempts for requests that fail with a transient error (connection reset, timeout, HTTP 5xx). Only reads and idempotent settings are retried. Defaults to `3`; set to `1` to disable retries. Can also be set using the `HRUI_RETRY_MAX_ATTEMPTS` environment variable.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"retry_max_backoff": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Upper bound for the exponential backoff between retries, as a Go duration string (e.g. `5s`, `500ms`). Defaults to `5s`. Can also be set using the `HRUI_RETRY_MAX_BACKOFF` environment variable.",
+			},
+			"strict_validation": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Report consistency problems found at plan time, such as a port whose PVID is a VLAN it is not an untagged member of, as errors instead of warnings. Defaults to `false`. Can also be set using the `HRUI_STRICT_VALIDATION` environment variable.",
+			},
+			"ca_cert_pem": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "PEM-encoded CA certificates trusted in addition to the system roots when `url` uses HTTPS. Can also be set using the `HRUI_CA_CERT_PEM` environment variable.",
+			},
+			"ca_cert_file": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Path to a file with PEM-encoded CA certificates trusted in addition to the system roots when `url` uses HTTPS. Can also be set using the `HRUI_CA_CERT_FILE` environment variable.",
+			},
+			"insecure_skip_verify": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Skip verification of the server certificate when `url` uses HTTPS. Only use this for testing. Can also be set using the `HRUI_INSECURE_SKIP_VERIFY` environment variable.",
+			},
+			"client_cert": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "PEM-encoded client certificate for mutual TLS. Requires `client_key`. Can also be set using the `HRUI_CLIENT_CERT` environment variable.",
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("client_key")),
+				},
+			},
+			"client_key": schema.StringAttribute{
+				Optional:            true,
+				Sensitive:           true,
+				MarkdownDescription: "PEM-encoded private key for `client_cert`. Can also be set using the `HRUI_CLIENT_KEY` environment variable.",
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("client_cert")),
+				},
+			},
+			"proxy_url": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "URL of an HTTP or HTTPS proxy used to reach the switch. Defaults to the standard `HTTP_PROXY`/`HTTPS_PROXY` environment variables. Can also be set using the `HRUI_PROXY_URL` environment variable.",
+			},
+			"devices": schema.MapNestedAttribute{
+				Optional:            true,
+				MarkdownDescription: "Additional switches managed by this provider, keyed by a device name. Resources and data sources select a switch with their `device` attribute. Named devices share the TLS, proxy, timeout, retry and concurrency settings of the provider and connect on first use.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"url": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "URL of the HRUI switch web interface.",
+						},
+						"username": schema.StringAttribute{
+							Optional:            true,
+							MarkdownDescription: "Username for authentication. Defaults to the provider `username`.",
+						},
+						"password": schema.StringAttribute{
+							Optional:            true,
+							Sensitive:           true,
+							MarkdownDescription: "Password for authentication. Defaults to the provider `password`.",
+						},
+						"autosave": schema.BoolAttribute{
+							Optional:            true,
+							MarkdownDescription: "Enable automatic saving of configuration changes. Defaults to the provider `autosave`.",
+						},
+					},
+				},
+			},
 		},
 	}
 }
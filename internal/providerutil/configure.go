@@ -7,20 +7,33 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 )
 
-// ConfigureClient extracts *sdk.HRUIClient from ProviderData for use in resource/datasource Configure methods.
+// ConfigureClient extracts the default *sdk.HRUIClient from ProviderData for use in resource/datasource Configure methods.
 // Returns nil without error when ProviderData is nil (framework calls Configure before the provider is ready
 // during planning/validation phases).
+//
+// Deprecated: Use ConfigureDevices, which also gives access to the devices of the provider `devices` map.
 func ConfigureClient(providerData any, diags *diag.Diagnostics) *sdk.HRUIClient {
+	devices := ConfigureDevices(providerData, diags)
+	if devices == nil {
+		return nil
+	}
+	return devices.Default
+}
+
+// ConfigureDevices extracts *Devices from ProviderData for use in resource/datasource Configure methods.
+// Returns nil without error when ProviderData is nil (framework calls Configure before the provider is ready
+// during planning/validation phases).
+func ConfigureDevices(providerData any, diags *diag.Diagnostics) *Devices {
 	if providerData == nil {
 		return nil
 	}
-	client, ok := providerData.(*sdk.HRUIClient)
+	devices, ok := providerData.(*Devices)
 	if !ok {
 		diags.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *sdk.HRUIClient, got: %T. Please report this issue to the provider developers.", providerData),
+			fmt.Sprintf("Expected *providerutil.Devices, got: %T. Please report this issue to the provider developers.", providerData),
 		)
 		return nil
 	}
-	return client
+	return devices
 }
@@ -0,0 +1,198 @@
+package providerutil
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/brennoo/terraform-provider-hrui/internal/sdk"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// DeviceAttribute is the name of the attribute that selects the device a resource or data source manages.
+const DeviceAttribute = "device"
+
+// importIDSeparator separates the device name from the resource ID in import IDs, e.g. "core@Port 1".
+const importIDSeparator = "@"
+
+// Devices holds the clients a provider instance manages: the default device configured through the
+// top-level provider attributes and the named devices of the provider `devices` map.
+//
+// The framework creates a new resource instance for every request, so resources select the client
+// for the device of the current plan or state at the start of each CRUD method.
+type Devices struct {
+	// Default is the client of the device configured by the top-level provider attributes.
+	// It is nil when only named devices are configured.
+	Default *sdk.HRUIClient
+
+	// PlanChecks enables the plan-time checks that read the switch, see RequireVLANMode and ValidatePVIDs.
+	PlanChecks bool
+
+	// StrictValidation reports consistency problems found at plan time as errors instead of warnings.
+	StrictValidation bool
+
+	named map[string]*deviceClient
+}
+
+// deviceClient connects to a named device on first use, so a provider managing many switches
+// only logs in to the ones the configuration actually touches.
+type deviceClient struct {
+	connect func(ctx context.Context) (*sdk.HRUIClient, error)
+
+	mu     sync.Mutex
+	client *sdk.HRUIClient
+}
+
+// get returns the client of the device, connecting with ctx if there is none yet. Only a
+// successful connection is kept: after an error, such as a failed login or a cancelled
+// request context, the next caller connects again.
+func (dc *deviceClient) get(ctx context.Context) (*sdk.HRUIClient, error) {
+	dc.mu.Lock()
+	defer dc.mu.Unlock()
+
+	if dc.client != nil {
+		return dc.client, nil
+	}
+	client, err := dc.connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+	dc.client = client
+	return client, nil
+}
+
+// NewDevices returns a device inventory with the given default client.
+func NewDevices(defaultClient *sdk.HRUIClient) *Devices {
+	return &Devices{
+		Default:    defaultClient,
+		PlanChecks: true,
+		named:      make(map[string]*deviceClient),
+	}
+}
+
+// Add registers a named device. connect is called the first time the device is used, and again
+// on later uses until it succeeds.
+func (d *Devices) Add(name string, connect func(ctx context.Context) (*sdk.HRUIClient, error)) {
+	d.named[name] = &deviceClient{connect: connect}
+}
+
+// Names returns the names of the named devices in alphabetical order.
+func (d *Devices) Names() []string {
+	names := make([]string, 0, len(d.named))
+	for name := range d.named {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Get returns the client of the named device, or the default client if device is null or empty.
+// It adds an error diagnostic and returns nil if the device is not configured or cannot be reached.
+func (d *Devices) Get(ctx context.Context, device types.String, diags *diag.Diagnostics) *sdk.HRUIClient {
+	if d == nil {
+		diags.AddError(
+			"Unconfigured HRUI Provider",
+			"The provider has not been configured yet. Please report this issue to the provider developers.",
+		)
+		return nil
+	}
+
+	name := device.ValueString()
+	if name == "" {
+		if d.Default == nil {
+			diags.AddError(
+				"Missing Device",
+				fmt.Sprintf("The provider has no default device ('url' is not set), so 'device' must be set to one of: %s.", strings.Join(d.Names(), ", ")),
+			)
+		}
+		return d.Default
+	}
+
+	named, ok := d.named[name]
+	if !ok {
+		diags.AddError(
+			"Unknown Device",
+			fmt.Sprintf("Device %q is not defined in the provider 'devices' map. Known devices: %s.", name, strings.Join(d.Names(), ", ")),
+		)
+		return nil
+	}
+
+	client, err := named.get(ctx)
+	if err != nil {
+		diags.AddError(
+			"Device Connection Error",
+			fmt.Sprintf("Unable to connect to device %q: %s", name, err),
+		)
+		return nil
+	}
+	return client
+}
+
+// attributeReader is implemented by tfsdk.Config, tfsdk.Plan and tfsdk.State.
+type attributeReader interface {
+	GetAttribute(ctx context.Context, p path.Path, target interface{}) diag.Diagnostics
+}
+
+// Client returns the client of the device selected by the `device` attribute of data.
+// It adds an error diagnostic and returns nil if no client is available.
+func (d *Devices) Client(ctx context.Context, data attributeReader, diags *diag.Diagnostics) *sdk.HRUIClient {
+	var device types.String
+	diags.Append(data.GetAttribute(ctx, path.Root(DeviceAttribute), &device)...)
+	if diags.HasError() {
+		return nil
+	}
+	return d.Get(ctx, device, diags)
+}
+
+// PlanClient returns the client of the device selected by plan for a plan-time check. It returns
+// nil if the checks are disabled, the plan destroys or does not change the resource, or the device
+// is not known yet or cannot be reached; connection errors are reported when the plan is applied.
+func (d *Devices) PlanClient(ctx context.Context, plan tfsdk.Plan, state tfsdk.State, diags *diag.Diagnostics) *sdk.HRUIClient {
+	if d == nil || !d.PlanChecks || plan.Raw.IsNull() || plan.Raw.Equal(state.Raw) {
+		return nil
+	}
+
+	var device types.String
+	diags.Append(plan.GetAttribute(ctx, path.Root(DeviceAttribute), &device)...)
+	if diags.HasError() || device.IsUnknown() {
+		return nil
+	}
+
+	var clientDiags diag.Diagnostics
+	client := d.Get(ctx, device, &clientDiags)
+	if clientDiags.HasError() {
+		return nil
+	}
+	return client
+}
+
+// KeepDevice copies the `device` attribute of data into state, so responses built from a fresh model
+// keep the configured device. It does nothing if the resource was removed from state.
+func KeepDevice(ctx context.Context, data attributeReader, state *tfsdk.State, diags *diag.Diagnostics) {
+	var device types.String
+	diags.Append(data.GetAttribute(ctx, path.Root(DeviceAttribute), &device)...)
+	SetDevice(ctx, device, state, diags)
+}
+
+// SetDevice sets the `device` attribute of state. It does nothing if the resource was removed from state.
+func SetDevice(ctx context.Context, device types.String, state *tfsdk.State, diags *diag.Diagnostics) {
+	if diags.HasError() || state.Raw.IsNull() {
+		return
+	}
+	diags.Append(state.SetAttribute(ctx, path.Root(DeviceAttribute), device)...)
+}
+
+// SplitImportID splits an import ID of the form "<device>@<id>" into the device and the resource ID.
+// IDs without a device prefix select the default device.
+func SplitImportID(importID string) (types.String, string) {
+	device, id, ok := strings.Cut(importID, importIDSeparator)
+	if !ok {
+		return types.StringNull(), importID
+	}
+	return types.StringValue(device), id
+}
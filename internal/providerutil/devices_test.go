@@ -0,0 +1,94 @@
+package providerutil
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/brennoo/terraform-provider-hrui/internal/sdk"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDevices_Get(t *testing.T) {
+	ctx := context.Background()
+	defaultClient := &sdk.HRUIClient{URL: "http://default"}
+	coreClient := &sdk.HRUIClient{URL: "http://core"}
+
+	connects := 0
+	devices := NewDevices(defaultClient)
+	devices.Add("core", func(context.Context) (*sdk.HRUIClient, error) {
+		connects++
+		return coreClient, nil
+	})
+	devices.Add("edge", func(context.Context) (*sdk.HRUIClient, error) {
+		return nil, errors.New("connection refused")
+	})
+
+	var diags diag.Diagnostics
+	require.Same(t, defaultClient, devices.Get(ctx, types.StringNull(), &diags))
+	require.Same(t, coreClient, devices.Get(ctx, types.StringValue("core"), &diags))
+	require.Same(t, coreClient, devices.Get(ctx, types.StringValue("core"), &diags))
+	require.False(t, diags.HasError())
+	require.Equal(t, 1, connects, "named devices should connect once")
+
+	require.Nil(t, devices.Get(ctx, types.StringValue("edge"), &diags))
+	require.Equal(t, "Device Connection Error", diags.Errors()[0].Summary())
+
+	diags = nil
+	require.Nil(t, devices.Get(ctx, types.StringValue("lab"), &diags))
+	require.Equal(t, "Unknown Device", diags.Errors()[0].Summary())
+	require.Contains(t, diags.Errors()[0].Detail(), "core, edge")
+}
+
+func TestDevices_GetRetriesFailedConnect(t *testing.T) {
+	ctx := context.Background()
+	coreClient := &sdk.HRUIClient{URL: "http://core"}
+
+	connects := 0
+	devices := NewDevices(nil)
+	devices.Add("core", func(ctx context.Context) (*sdk.HRUIClient, error) {
+		connects++
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		if connects == 2 {
+			return nil, errors.New("login failed")
+		}
+		return coreClient, nil
+	})
+
+	// A cancelled request context must not poison the device for later requests.
+	cancelled, cancel := context.WithCancel(ctx)
+	cancel()
+	var diags diag.Diagnostics
+	require.Nil(t, devices.Get(cancelled, types.StringValue("core"), &diags))
+	require.Equal(t, "Device Connection Error", diags.Errors()[0].Summary())
+
+	diags = nil
+	require.Nil(t, devices.Get(ctx, types.StringValue("core"), &diags))
+	require.Contains(t, diags.Errors()[0].Detail(), "login failed")
+
+	diags = nil
+	require.Same(t, coreClient, devices.Get(ctx, types.StringValue("core"), &diags))
+	require.Same(t, coreClient, devices.Get(ctx, types.StringValue("core"), &diags))
+	require.False(t, diags.HasError())
+	require.Equal(t, 3, connects, "only a successful connection is kept")
+}
+
+func TestDevices_GetWithoutDefault(t *testing.T) {
+	var diags diag.Diagnostics
+	require.Nil(t, NewDevices(nil).Get(context.Background(), types.StringNull(), &diags))
+	require.Equal(t, "Missing Device", diags.Errors()[0].Summary())
+}
+
+func TestSplitImportID(t *testing.T) {
+	device, id := SplitImportID("core@Port 1")
+	require.Equal(t, types.StringValue("core"), device)
+	require.Equal(t, "Port 1", id)
+
+	device, id = SplitImportID("10")
+	require.True(t, device.IsNull())
+	require.Equal(t, "10", id)
+}
@@ -0,0 +1,21 @@
+package providerutil
+
+import (
+	"errors"
+
+	"github.com/brennoo/terraform-provider-hrui/internal/sdk"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// AddUnsupportedFeatureError adds an "Unsupported Feature" diagnostic if err was caused by a feature
+// the device firmware does not support, and reports whether it did.
+func AddUnsupportedFeatureError(diags *diag.Diagnostics, err error) bool {
+	if !errors.Is(err, sdk.ErrUnsupportedFeature) {
+		return false
+	}
+	diags.AddError(
+		"Unsupported Feature",
+		err.Error()+". Remove the setting from the configuration or upgrade the switch firmware.",
+	)
+	return true
+}
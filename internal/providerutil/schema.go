@@ -0,0 +1,39 @@
+package providerutil
+
+import (
+	actionschema "github.com/hashicorp/terraform-plugin-framework/action/schema"
+	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+)
+
+const deviceDescription = "Name of the device in the provider `devices` map to manage. Defaults to the device configured by the provider `url`."
+
+// DeviceResourceAttribute returns the schema of the `device` attribute shared by all resources.
+// Moving a resource to another device replaces it.
+func DeviceResourceAttribute() resourceschema.StringAttribute {
+	return resourceschema.StringAttribute{
+		Optional:            true,
+		MarkdownDescription: deviceDescription,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	}
+}
+
+// DeviceDataSourceAttribute returns the schema of the `device` attribute shared by all data sources.
+func DeviceDataSourceAttribute() datasourceschema.StringAttribute {
+	return datasourceschema.StringAttribute{
+		Optional:            true,
+		MarkdownDescription: deviceDescription,
+	}
+}
+
+// DeviceActionAttribute returns the schema of the `device` attribute shared by all actions.
+func DeviceActionAttribute() actionschema.StringAttribute {
+	return actionschema.StringAttribute{
+		Optional:            true,
+		MarkdownDescription: deviceDescription,
+	}
+}
@@ -0,0 +1,93 @@
+package providerutil
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/brennoo/terraform-provider-hrui/internal/sdk"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// RequireVLANMode adds an error diagnostic at plan time if the switch selected by plan is not in
+// the given VLAN mode, so 802.1Q and port-based VLAN resources fail before anything is applied.
+// The check is skipped when PlanClient returns no client or the VLAN mode cannot be read, e.g.
+// because the firmware has no VLAN mode selector.
+func RequireVLANMode(ctx context.Context, devices *Devices, plan tfsdk.Plan, state tfsdk.State, mode sdk.VLANMode, resourceType string, diags *diag.Diagnostics) {
+	client := devices.PlanClient(ctx, plan, state, diags)
+	if client == nil {
+		return
+	}
+
+	current, err := client.GetVLANMode(ctx)
+	if err != nil {
+		tflog.Debug(ctx, "Unable to check the VLAN mode at plan time", map[string]any{"error": err.Error()})
+		return
+	}
+
+	if current != mode {
+		diags.AddError(
+			"Wrong VLAN Mode",
+			fmt.Sprintf("%s requires the switch to be in %q VLAN mode, but it is in %q mode. "+
+				"Set the mode with the hrui_vlan_mode resource and apply it first.", resourceType, mode, current),
+		)
+	}
+}
+
+// PVIDPlan applies the planned change of a resource to the VLANs and port settings read from the
+// switch. It returns the updated VLANs and the names of the ports whose PVID or untagged VLAN
+// membership the resource manages, or no ports if the plan cannot be checked yet.
+type PVIDPlan func(ctx context.Context, vlans []*sdk.Vlan, ports []*sdk.PortVLANConfig) ([]*sdk.Vlan, []string)
+
+// ValidatePVIDs reports, at plan time, the ports whose planned PVID is not a VLAN they are an
+// untagged member of. The problems are warnings, or errors if the provider `strict_validation`
+// flag is set. Only the ports returned by apply are reported. Unless complete is set, a PVID
+// that is not an existing VLAN is not reported, as another resource of the plan may create it.
+// Like RequireVLANMode, the check is skipped when PlanClient returns no client or the switch
+// cannot be read.
+func ValidatePVIDs(ctx context.Context, devices *Devices, plan tfsdk.Plan, state tfsdk.State, resourceType string, complete bool, apply PVIDPlan, diags *diag.Diagnostics) {
+	client := devices.PlanClient(ctx, plan, state, diags)
+	if client == nil {
+		return
+	}
+
+	vlans, err := client.ListVLANs(ctx)
+	if err != nil {
+		tflog.Debug(ctx, "Unable to check the PVIDs at plan time", map[string]any{"error": err.Error()})
+		return
+	}
+	ports, err := client.ListPortVLANConfigs(ctx)
+	if err != nil {
+		tflog.Debug(ctx, "Unable to check the PVIDs at plan time", map[string]any{"error": err.Error()})
+		return
+	}
+
+	vlans, managed := apply(ctx, vlans, ports)
+	reportPVIDMismatches(resourceType, sdk.PVIDMismatches(vlans, ports), managed, complete, devices.StrictValidation, diags)
+}
+
+// reportPVIDMismatches adds a diagnostic for each mismatch of a managed port.
+func reportPVIDMismatches(resourceType string, mismatches []sdk.PVIDMismatch, managed []string, complete, strict bool, diags *diag.Diagnostics) {
+	for _, mismatch := range mismatches {
+		if !slices.Contains(managed, mismatch.Port) || (!mismatch.VLANExists && !complete) {
+			continue
+		}
+
+		fix := fmt.Sprintf("Make the port an untagged member of VLAN %d or change its PVID.", mismatch.PVID)
+		if !mismatch.VLANExists {
+			fix = fmt.Sprintf("Create VLAN %d with the port as an untagged member or change its PVID.", mismatch.PVID)
+		}
+
+		summary := "PVID Does Not Match VLAN Membership"
+		detail := fmt.Sprintf("%s: %s. The switch accepts this, but untagged frames received on the port are "+
+			"assigned to VLAN %d and replies cannot be sent back untagged, so the traffic is dropped. %s",
+			resourceType, mismatch, mismatch.PVID, fix)
+		if strict {
+			diags.AddError(summary, detail)
+		} else {
+			diags.AddWarning(summary, detail+" Set the provider strict_validation flag to make this an error.")
+		}
+	}
+}
@@ -0,0 +1,28 @@
+package providerutil
+
+import (
+	"testing"
+
+	"github.com/brennoo/terraform-provider-hrui/internal/sdk"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReportPVIDMismatches(t *testing.T) {
+	mismatches := []sdk.PVIDMismatch{
+		{Port: "Port 1", PVID: 10, VLANExists: true},
+		{Port: "Port 2", PVID: 20},
+		{Port: "Port 3", PVID: 30, VLANExists: true},
+	}
+
+	var diags diag.Diagnostics
+	reportPVIDMismatches("hrui_vlan_vid", mismatches, []string{"Port 1", "Port 2"}, false, false, &diags)
+	require.False(t, diags.HasError())
+	require.Len(t, diags, 1, "unmanaged ports and missing VLANs are skipped")
+	require.Contains(t, diags[0].Detail(), "hrui_vlan_vid: port 'Port 1' has PVID 10, but is not an untagged member of VLAN 10")
+
+	diags = nil
+	reportPVIDMismatches("hrui_vlan_table", mismatches, []string{"Port 1", "Port 2"}, true, true, &diags)
+	require.Equal(t, 2, diags.ErrorsCount(), "strict validation reports errors")
+	require.Contains(t, diags[1].Detail(), "VLAN 20 does not exist")
+}
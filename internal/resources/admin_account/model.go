@@ -0,0 +1,13 @@
+package admin_account
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// adminAccountModel represents the state model for the admin account Terraform resource.
+type adminAccountModel struct {
+	Username          types.String `tfsdk:"username"`
+	PasswordWO        types.String `tfsdk:"password_wo"`
+	PasswordWOVersion types.Int64  `tfsdk:"password_wo_version"`
+	Device            types.String `tfsdk:"device"`
+}
@@ -0,0 +1,198 @@
+package admin_account
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/brennoo/terraform-provider-hrui/internal/providerutil"
+	"github.com/brennoo/terraform-provider-hrui/internal/sdk"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the required interfaces.
+var (
+	_ resource.Resource                = &adminAccountResource{}
+	_ resource.ResourceWithConfigure   = &adminAccountResource{}
+	_ resource.ResourceWithImportState = &adminAccountResource{}
+)
+
+// adminAccountResource is the implementation of the admin account Terraform resource.
+type adminAccountResource struct {
+	client  *sdk.HRUIClient
+	devices *providerutil.Devices
+}
+
+// NewResource creates a new instance of the admin account resource.
+func NewResource() resource.Resource {
+	return &adminAccountResource{}
+}
+
+// Metadata sets the resource name for Terraform.
+func (r *adminAccountResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_admin_account"
+}
+
+// Schema defines the schema for the admin account resource.
+func (r *adminAccountResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the administrator account used to log in to the switch.",
+		MarkdownDescription: "Manages the administrator account used to log in to the switch. " +
+			"After a change the provider logs in with the new credentials, so the rest of the apply keeps working. " +
+			"Update the provider `username` and `password` before the next run. Requires Terraform 1.11 or later.",
+		Attributes: map[string]schema.Attribute{
+			"device": providerutil.DeviceResourceAttribute(),
+			"username": schema.StringAttribute{
+				Required:    true,
+				Description: "The administrator username.",
+			},
+			"password_wo": schema.StringAttribute{
+				Required:    true,
+				Sensitive:   true,
+				WriteOnly:   true,
+				Description: "The administrator password. Write-only: it is never stored in the state.",
+			},
+			"password_wo_version": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Version of `password_wo`. Change it to apply a new password, as write-only values are not compared between runs.",
+			},
+		},
+	}
+}
+
+// Configure assigns the provider-configured client to the resource.
+func (r *adminAccountResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.devices = providerutil.ConfigureDevices(req.ProviderData, &resp.Diagnostics)
+}
+
+// Create sets the administrator credentials.
+func (r *adminAccountResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	r.client = r.devices.Client(ctx, req.Plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer providerutil.KeepDevice(ctx, req.Plan, &resp.State, &resp.Diagnostics)
+
+	tflog.Debug(ctx, "Creating admin account")
+
+	// Write-only values are only available in the configuration.
+	var config adminAccountModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.SetAdminAccount(ctx, &sdk.AdminAccount{
+		Username: config.Username.ValueString(),
+		Password: config.PasswordWO.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Admin Account",
+			fmt.Sprintf("Failed to set admin account: %s", err),
+		)
+		return
+	}
+
+	config.PasswordWO = types.StringNull()
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+
+	tflog.Debug(ctx, "Admin account created")
+}
+
+// Read retrieves the administrator username from the device and updates the state.
+func (r *adminAccountResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	r.client = r.devices.Client(ctx, req.State, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer providerutil.KeepDevice(ctx, req.State, &resp.State, &resp.Diagnostics)
+
+	tflog.Debug(ctx, "Reading admin account")
+
+	var state adminAccountModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	username, err := r.client.GetAdminUsername(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Admin Account",
+			fmt.Sprintf("Failed to read admin account: %s", err),
+		)
+		return
+	}
+
+	state.Username = types.StringValue(username)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+
+	tflog.Debug(ctx, "Admin account read")
+}
+
+// Update applies a changed username or password version.
+func (r *adminAccountResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	r.client = r.devices.Client(ctx, req.Plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer providerutil.KeepDevice(ctx, req.Plan, &resp.State, &resp.Diagnostics)
+
+	tflog.Debug(ctx, "Updating admin account")
+
+	var config adminAccountModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.SetAdminAccount(ctx, &sdk.AdminAccount{
+		Username: config.Username.ValueString(),
+		Password: config.PasswordWO.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Admin Account",
+			fmt.Sprintf("Failed to update admin account: %s", err),
+		)
+		return
+	}
+
+	config.PasswordWO = types.StringNull()
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+
+	tflog.Debug(ctx, "Admin account updated")
+}
+
+// Delete removes the resource from the state. The switch always has an administrator account,
+// so the current credentials are left as they are.
+func (r *adminAccountResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Deleting admin account; leaving the device credentials unchanged")
+}
+
+// ImportState imports the administrator username of the device.
+func (r *adminAccountResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	device, importID := providerutil.SplitImportID(req.ID)
+	r.client = r.devices.Get(ctx, device, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer providerutil.SetDevice(ctx, device, &resp.State, &resp.Diagnostics)
+	req.ID = importID
+
+	tflog.Debug(ctx, "Importing admin account", map[string]any{"id": req.ID})
+
+	username, err := r.client.GetAdminUsername(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Importing Admin Account", fmt.Sprintf("Unable to import admin account: %s", err))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &adminAccountModel{
+		Username:          types.StringValue(username),
+		PasswordWO:        types.StringNull(),
+		PasswordWOVersion: types.Int64Null(),
+	})...)
+}
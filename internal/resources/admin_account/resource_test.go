@@ -0,0 +1,60 @@
+package admin_account_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	// Import the test helper package.
+	"github.com/brennoo/terraform-provider-hrui/internal/provider"
+)
+
+// TestAccAdminAccountResource provides an acceptance test for the
+// hrui_admin_account resource. The account is set to the credentials
+// the provider logs in with, so recording does not lock out the switch.
+func TestAccAdminAccountResource(t *testing.T) {
+	providerFactories := provider.TestAccProtoV6ProviderFactories(t, "admin_account_resource_test")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			// Step 1: Create the resource, which posts the account form,
+			// logs in again and saves the change
+			{
+				Config: testAccAdminAccountResourceConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("hrui_admin_account.test", "username", testAccEnv("HRUI_USERNAME")),
+					resource.TestCheckResourceAttr("hrui_admin_account.test", "password_wo_version", "1"),
+					// Write-only values are never stored in the state.
+					resource.TestCheckNoResourceAttr("hrui_admin_account.test", "password_wo"),
+				),
+			},
+			// Destroying the resource leaves the account unchanged.
+		},
+	})
+}
+
+// testAccEnv returns the provider credential in the environment variable name.
+// Replaying does not need the real credentials, so it defaults to "admin".
+func testAccEnv(name string) string {
+	if value := os.Getenv(name); value != "" {
+		return value
+	}
+	return "admin"
+}
+
+// testAccAdminAccountResourceConfig generates the HCL for the
+// hrui_admin_account resource with the provider credentials.
+func testAccAdminAccountResourceConfig() string {
+	return fmt.Sprintf(`
+provider "hrui" {}
+
+resource "hrui_admin_account" "test" {
+  username            = %q
+  password_wo         = %q
+  password_wo_version = 1
+}
+`, testAccEnv("HRUI_USERNAME"), testAccEnv("HRUI_PASSWORD"))
+}
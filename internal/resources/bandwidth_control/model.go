@@ -7,4 +7,5 @@ type bandwidthControlModel struct {
 	Port        types.String `tfsdk:"port"`
 	IngressRate types.String `tfsdk:"ingress_rate"`
 	EgressRate  types.String `tfsdk:"egress_rate"`
+	Device      types.String `tfsdk:"device"`
 }
@@ -22,7 +22,8 @@ var (
 
 // bandwidthControlResource is the implementation of the resource.
 type bandwidthControlResource struct {
-	client *sdk.HRUIClient
+	client  *sdk.HRUIClient
+	devices *providerutil.Devices
 }
 
 // NewResource creates a new instance of the bandwidth control resource.
@@ -40,6 +41,7 @@ func (r *bandwidthControlResource) Schema(_ context.Context, req resource.Schema
 	resp.Schema = schema.Schema{
 		Description: "Configures bandwidth control on a specific port.",
 		Attributes: map[string]schema.Attribute{
+			"device": providerutil.DeviceResourceAttribute(),
 			"port": schema.StringAttribute{
 				Description: "Port where bandwidth control is configured (e.g., 'Port 1', 'Trunk2').",
 				Required:    true,
@@ -58,11 +60,17 @@ func (r *bandwidthControlResource) Schema(_ context.Context, req resource.Schema
 
 // Configure assigns the SDK client from provider configuration.
 func (r *bandwidthControlResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	r.client = providerutil.ConfigureClient(req.ProviderData, &resp.Diagnostics)
+	r.devices = providerutil.ConfigureDevices(req.ProviderData, &resp.Diagnostics)
 }
 
 // Create sets bandwidth control on a port.
 func (r *bandwidthControlResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	r.client = r.devices.Client(ctx, req.Plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer providerutil.KeepDevice(ctx, req.Plan, &resp.State, &resp.Diagnostics)
+
 	var data bandwidthControlModel
 	diags := req.Plan.Get(ctx, &data)
 	resp.Diagnostics.Append(diags...)
@@ -111,6 +119,12 @@ func (r *bandwidthControlResource) Create(ctx context.Context, req resource.Crea
 
 // Read retrieves the current state for the resource.
 func (r *bandwidthControlResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	r.client = r.devices.Client(ctx, req.State, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer providerutil.KeepDevice(ctx, req.State, &resp.State, &resp.Diagnostics)
+
 	var state bandwidthControlModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -167,6 +181,12 @@ func (r *bandwidthControlResource) Read(ctx context.Context, req resource.ReadRe
 
 // Update modifies bandwidth control settings.
 func (r *bandwidthControlResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	r.client = r.devices.Client(ctx, req.Plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer providerutil.KeepDevice(ctx, req.Plan, &resp.State, &resp.Diagnostics)
+
 	var plan bandwidthControlModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -215,6 +235,11 @@ func (r *bandwidthControlResource) Update(ctx context.Context, req resource.Upda
 
 // Delete disables bandwidth control on a port.
 func (r *bandwidthControlResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	r.client = r.devices.Client(ctx, req.State, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var state bandwidthControlModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -259,6 +284,14 @@ func normalizeRate(rate string) string {
 
 // ImportState imports an existing Bandwidth Control resource by port name.
 func (r *bandwidthControlResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	device, importID := providerutil.SplitImportID(req.ID)
+	r.client = r.devices.Get(ctx, device, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer providerutil.SetDevice(ctx, device, &resp.State, &resp.Diagnostics)
+	req.ID = importID
+
 	tflog.Debug(ctx, "Importing bandwidth control", map[string]any{"id": req.ID})
 
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("port"), req.ID)...)
@@ -0,0 +1,90 @@
+package config_backup
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/brennoo/terraform-provider-hrui/internal/providerutil"
+	"github.com/brennoo/terraform-provider-hrui/internal/sdk"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure `configBackupDataSource` implements the `datasource.DataSource` interface.
+var _ datasource.DataSource = &configBackupDataSource{}
+
+// configBackupDataSource defines the structure of the configuration backup data source.
+type configBackupDataSource struct {
+	client  *sdk.HRUIClient
+	devices *providerutil.Devices
+}
+
+// NewDataSource creates a new instance of the configuration backup data source.
+func NewDataSource() datasource.DataSource {
+	return &configBackupDataSource{}
+}
+
+// Metadata sets the data source type name.
+func (d *configBackupDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_config_backup"
+}
+
+// Schema defines the schema for the configuration backup data source.
+func (d *configBackupDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Data source for downloading the configuration file of the switch.",
+		Attributes: map[string]schema.Attribute{
+			"device": providerutil.DeviceDataSourceAttribute(),
+			"id": schema.StringAttribute{
+				Description: "The SHA-256 checksum of the configuration file.",
+				Computed:    true,
+			},
+			"content_base64": schema.StringAttribute{
+				Description: "The configuration file, base64-encoded. It contains the switch credentials.",
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"sha256": schema.StringAttribute{
+				Description: "The hex-encoded SHA-256 checksum of the configuration file, to detect changes made outside Terraform.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Configure assigns the provider-configured client to the data source.
+func (d *configBackupDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.devices = providerutil.ConfigureDevices(req.ProviderData, &resp.Diagnostics)
+}
+
+// Read downloads the configuration file from the switch.
+func (d *configBackupDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	d.client = d.devices.Client(ctx, req.Config, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer providerutil.KeepDevice(ctx, req.Config, &resp.State, &resp.Diagnostics)
+
+	config, err := d.client.BackupConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Configuration Backup",
+			fmt.Sprintf("Could not download the configuration file: %s", err),
+		)
+		return
+	}
+
+	sum := sha256.Sum256(config)
+	checksum := hex.EncodeToString(sum[:])
+
+	state := configBackupModel{
+		ID:            types.StringValue(checksum),
+		ContentBase64: types.StringValue(base64.StdEncoding.EncodeToString(config)),
+		SHA256:        types.StringValue(checksum),
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
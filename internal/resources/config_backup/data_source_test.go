@@ -0,0 +1,35 @@
+package config_backup_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/brennoo/terraform-provider-hrui/internal/provider"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccConfigBackupDataSource(t *testing.T) {
+	providerFactories := provider.TestAccProtoV6ProviderFactories(t, "config_backup_data_source_test")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigBackupDataSourceConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.hrui_config_backup.test", "content_base64"),
+					resource.TestMatchResourceAttr("data.hrui_config_backup.test", "sha256", regexp.MustCompile(`^[0-9a-f]{64}$`)),
+					resource.TestCheckResourceAttrPair("data.hrui_config_backup.test", "id", "data.hrui_config_backup.test", "sha256"),
+				),
+			},
+		},
+	})
+}
+
+func testAccConfigBackupDataSourceConfig() string {
+	return `
+provider "hrui" {}
+
+data "hrui_config_backup" "test" {}
+`
+}
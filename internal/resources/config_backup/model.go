@@ -0,0 +1,13 @@
+package config_backup
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// configBackupModel represents the state model for the configuration backup data source.
+type configBackupModel struct {
+	ID            types.String `tfsdk:"id"`
+	ContentBase64 types.String `tfsdk:"content_base64"`
+	SHA256        types.String `tfsdk:"sha256"`
+	Device        types.String `tfsdk:"device"`
+}
@@ -0,0 +1,13 @@
+package config_restore
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// configRestoreModel represents the state model for the configuration restore Terraform resource.
+type configRestoreModel struct {
+	ID            types.String `tfsdk:"id"`
+	ContentBase64 types.String `tfsdk:"content_base64"`
+	SHA256        types.String `tfsdk:"sha256"`
+	Device        types.String `tfsdk:"device"`
+}
@@ -0,0 +1,148 @@
+package config_restore
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/brennoo/terraform-provider-hrui/internal/providerutil"
+	"github.com/brennoo/terraform-provider-hrui/internal/sdk"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the required interfaces.
+var (
+	_ resource.Resource              = &configRestoreResource{}
+	_ resource.ResourceWithConfigure = &configRestoreResource{}
+)
+
+// configRestoreResource uploads a configuration file to the switch.
+type configRestoreResource struct {
+	client  *sdk.HRUIClient
+	devices *providerutil.Devices
+}
+
+// NewResource creates a new instance of the configuration restore resource.
+func NewResource() resource.Resource {
+	return &configRestoreResource{}
+}
+
+// Metadata sets the resource name for Terraform.
+func (r *configRestoreResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_config_restore"
+}
+
+// Schema defines the schema for the configuration restore resource.
+func (r *configRestoreResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Restores a configuration file on the switch.",
+		MarkdownDescription: "Restores a configuration file on the switch, e.g. one downloaded with the `hrui_config_backup` data source. " +
+			"The file is uploaded when the resource is created or `content_base64` changes; destroying the resource leaves the configuration of the switch unchanged. " +
+			"Depending on the firmware, the switch may need a reboot to apply the restored configuration.",
+		Attributes: map[string]schema.Attribute{
+			"device": providerutil.DeviceResourceAttribute(),
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The SHA-256 checksum of the restored configuration file.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"content_base64": schema.StringAttribute{
+				Required:    true,
+				Sensitive:   true,
+				Description: "The configuration file to restore, base64-encoded.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"sha256": schema.StringAttribute{
+				Computed:    true,
+				Description: "The hex-encoded SHA-256 checksum of the restored configuration file.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure assigns the provider-configured client to the resource.
+func (r *configRestoreResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.devices = providerutil.ConfigureDevices(req.ProviderData, &resp.Diagnostics)
+}
+
+// Create uploads the configuration file.
+func (r *configRestoreResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	r.client = r.devices.Client(ctx, req.Plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer providerutil.KeepDevice(ctx, req.Plan, &resp.State, &resp.Diagnostics)
+
+	var plan configRestoreModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config, err := base64.StdEncoding.DecodeString(plan.ContentBase64.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid Configuration File",
+			fmt.Sprintf("content_base64 is not valid base64: %s", err),
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "Restoring configuration", map[string]any{"size": len(config)})
+
+	if err := r.client.RestoreConfig(ctx, config); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Restoring Configuration",
+			fmt.Sprintf("Failed to restore the configuration file: %s", err),
+		)
+		return
+	}
+
+	sum := sha256.Sum256(config)
+	plan.SHA256 = types.StringValue(hex.EncodeToString(sum[:]))
+	plan.ID = plan.SHA256
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+
+	tflog.Debug(ctx, "Configuration restored")
+}
+
+// Read keeps the state as is: the restore is a one-off upload and the device does not report it.
+func (r *configRestoreResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state configRestoreModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update is never called with changes, as all configurable attributes require replacement.
+func (r *configRestoreResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan configRestoreModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete removes the resource from the state. The configuration of the switch is left unchanged.
+func (r *configRestoreResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Deleting configuration restore; leaving the device configuration unchanged")
+}
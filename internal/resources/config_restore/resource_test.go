@@ -0,0 +1,47 @@
+package config_restore_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	// Import the test helper package.
+	"github.com/brennoo/terraform-provider-hrui/internal/provider"
+)
+
+// TestAccConfigRestoreResource provides an acceptance test for the
+// hrui_config_restore resource. It restores the configuration the
+// hrui_config_backup data source just downloaded, so recording leaves
+// the switch as it was.
+func TestAccConfigRestoreResource(t *testing.T) {
+	providerFactories := provider.TestAccProtoV6ProviderFactories(t, "config_restore_resource_test")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			// Step 1: Upload the configuration file
+			{
+				Config: testAccConfigRestoreResourceConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("hrui_config_restore.test", "sha256", "data.hrui_config_backup.test", "sha256"),
+					resource.TestCheckResourceAttrPair("hrui_config_restore.test", "id", "hrui_config_restore.test", "sha256"),
+				),
+			},
+			// Destroying the resource leaves the configuration of the switch unchanged.
+		},
+	})
+}
+
+// testAccConfigRestoreResourceConfig generates the HCL that restores the
+// current configuration of the switch.
+func testAccConfigRestoreResourceConfig() string {
+	return `
+provider "hrui" {}
+
+data "hrui_config_backup" "test" {}
+
+resource "hrui_config_restore" "test" {
+  content_base64 = data.hrui_config_backup.test.content_base64
+}
+`
+}
@@ -6,5 +6,6 @@ import (
 
 // eeeModel represents the state model for the EEE Terraform resource.
 type eeeModel struct {
-	Enabled types.Bool `tfsdk:"enabled"`
+	Enabled types.Bool   `tfsdk:"enabled"`
+	Device  types.String `tfsdk:"device"`
 }
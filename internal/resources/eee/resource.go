@@ -21,7 +21,8 @@ var (
 
 // eeeResource is the implementation of the EEE Terraform resource.
 type eeeResource struct {
-	client *sdk.HRUIClient
+	client  *sdk.HRUIClient
+	devices *providerutil.Devices
 }
 
 // NewResource creates a new instance of the EEE resource.
@@ -39,6 +40,7 @@ func (r *eeeResource) Schema(ctx context.Context, req resource.SchemaRequest, re
 	resp.Schema = schema.Schema{
 		Description: "Manages the Energy Efficient Ethernet (EEE) settings.",
 		Attributes: map[string]schema.Attribute{
+			"device": providerutil.DeviceResourceAttribute(),
 			"enabled": schema.BoolAttribute{
 				Required:    true,
 				Description: "Whether EEE is enabled (`true`) or disabled (`false`).",
@@ -49,11 +51,17 @@ func (r *eeeResource) Schema(ctx context.Context, req resource.SchemaRequest, re
 
 // Configure assigns the provider-configured client to the resource.
 func (r *eeeResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	r.client = providerutil.ConfigureClient(req.ProviderData, &resp.Diagnostics)
+	r.devices = providerutil.ConfigureDevices(req.ProviderData, &resp.Diagnostics)
 }
 
 // Create sets the initial EEE state.
 func (r *eeeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	r.client = r.devices.Client(ctx, req.Plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer providerutil.KeepDevice(ctx, req.Plan, &resp.State, &resp.Diagnostics)
+
 	tflog.Debug(ctx, "Creating EEE settings")
 
 	// Parse the plan (input configuration from the user)
@@ -81,6 +89,12 @@ func (r *eeeResource) Create(ctx context.Context, req resource.CreateRequest, re
 
 // Read retrieves the current EEE status from the device and updates the state.
 func (r *eeeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	r.client = r.devices.Client(ctx, req.State, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer providerutil.KeepDevice(ctx, req.State, &resp.State, &resp.Diagnostics)
+
 	tflog.Debug(ctx, "Reading EEE settings")
 
 	// Parse the current state
@@ -109,6 +123,12 @@ func (r *eeeResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 
 // Update changes the EEE status to the new value in the plan.
 func (r *eeeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	r.client = r.devices.Client(ctx, req.Plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer providerutil.KeepDevice(ctx, req.Plan, &resp.State, &resp.Diagnostics)
+
 	tflog.Debug(ctx, "Updating EEE settings")
 
 	// Parse the plan (new configuration)
@@ -136,6 +156,11 @@ func (r *eeeResource) Update(ctx context.Context, req resource.UpdateRequest, re
 
 // Delete disables EEE by setting the property to its default value (`false`).
 func (r *eeeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	r.client = r.devices.Client(ctx, req.State, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	tflog.Debug(ctx, "Deleting EEE settings")
 
 	// Call the SDK to disable EEE (default is off)
@@ -150,6 +175,14 @@ func (r *eeeResource) Delete(ctx context.Context, req resource.DeleteRequest, re
 
 // ImportState imports an existing EEE resource by fetching the current state.
 func (r *eeeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	device, importID := providerutil.SplitImportID(req.ID)
+	r.client = r.devices.Get(ctx, device, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer providerutil.SetDevice(ctx, device, &resp.State, &resp.Diagnostics)
+	req.ID = importID
+
 	tflog.Debug(ctx, "Importing EEE settings", map[string]any{"id": req.ID})
 
 	enabled, err := r.client.GetEEE(ctx)
@@ -0,0 +1,13 @@
+package firmware
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// firmwareModel represents the state model for the firmware Terraform resource.
+type firmwareModel struct {
+	ImagePath     types.String `tfsdk:"image_path"`
+	Version       types.String `tfsdk:"version"`
+	RebootTimeout types.String `tfsdk:"reboot_timeout"`
+	Device        types.String `tfsdk:"device"`
+}
@@ -0,0 +1,204 @@
+package firmware
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/brennoo/terraform-provider-hrui/internal/providerutil"
+	"github.com/brennoo/terraform-provider-hrui/internal/sdk"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the required interfaces.
+var (
+	_ resource.Resource              = &firmwareResource{}
+	_ resource.ResourceWithConfigure = &firmwareResource{}
+)
+
+// firmwareResource is the implementation of the firmware Terraform resource.
+type firmwareResource struct {
+	client  *sdk.HRUIClient
+	devices *providerutil.Devices
+}
+
+// NewResource creates a new instance of the firmware resource.
+func NewResource() resource.Resource {
+	return &firmwareResource{}
+}
+
+// Metadata sets the resource name for Terraform.
+func (r *firmwareResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_firmware"
+}
+
+// Schema defines the schema for the firmware resource.
+func (r *firmwareResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Upgrades the firmware of the switch.",
+		MarkdownDescription: "Upgrades the firmware of the switch. " +
+			"If the switch does not run `version`, the image is uploaded, the provider waits for the switch to reboot, logs in again and checks that it reports `version`. " +
+			"Nothing is uploaded while the switch already runs `version`. Destroying the resource leaves the firmware of the switch unchanged.",
+		Attributes: map[string]schema.Attribute{
+			"device": providerutil.DeviceResourceAttribute(),
+			"image_path": schema.StringAttribute{
+				Required:    true,
+				Description: "Path to the firmware image file on the machine running Terraform.",
+			},
+			"version": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The firmware version contained in the image, as shown on the System Info page (e.g. `V1.9.1`). The upgrade fails if the switch reports another version after the reboot.",
+			},
+			"reboot_timeout": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "How long to wait for the switch to come back with the new firmware, as a Go duration string (e.g. `10m`). Defaults to `5m`.",
+			},
+		},
+	}
+}
+
+// Configure assigns the provider-configured client to the resource.
+func (r *firmwareResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.devices = providerutil.ConfigureDevices(req.ProviderData, &resp.Diagnostics)
+}
+
+// Create upgrades the switch to the planned firmware version.
+func (r *firmwareResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	r.client = r.devices.Client(ctx, req.Plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer providerutil.KeepDevice(ctx, req.Plan, &resp.State, &resp.Diagnostics)
+
+	var plan firmwareModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.upgrade(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read checks the firmware version reported by the switch. If it no longer matches, the
+// reported version is stored so the next plan upgrades the switch again.
+func (r *firmwareResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	r.client = r.devices.Client(ctx, req.State, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer providerutil.KeepDevice(ctx, req.State, &resp.State, &resp.Diagnostics)
+
+	var state firmwareModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	info, err := r.client.GetSystemInfo(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Firmware Version",
+			fmt.Sprintf("Could not read the firmware version of the switch: %s", err),
+		)
+		return
+	}
+
+	if !sdk.FirmwareVersionMatches(info.FirmwareVersion, state.Version.ValueString()) {
+		tflog.Warn(ctx, "Switch runs another firmware version than the one in the state", map[string]any{
+			"firmware_version": info.FirmwareVersion,
+			"expected_version": state.Version.ValueString(),
+		})
+		state.Version = types.StringValue(info.FirmwareVersion)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update upgrades the switch if the planned version differs from the one it runs.
+func (r *firmwareResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	r.client = r.devices.Client(ctx, req.Plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer providerutil.KeepDevice(ctx, req.Plan, &resp.State, &resp.Diagnostics)
+
+	var plan firmwareModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.upgrade(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete removes the resource from the state. The firmware of the switch is left unchanged.
+func (r *firmwareResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Deleting firmware; leaving the device firmware unchanged")
+}
+
+// upgrade reads the image and installs it unless the switch already runs the planned version.
+func (r *firmwareResource) upgrade(ctx context.Context, plan *firmwareModel, diags *diag.Diagnostics) {
+	rebootTimeout := sdk.DefaultFirmwareRebootTimeout
+	if !plan.RebootTimeout.IsNull() && !plan.RebootTimeout.IsUnknown() {
+		var err error
+		rebootTimeout, err = time.ParseDuration(plan.RebootTimeout.ValueString())
+		if err != nil || rebootTimeout <= 0 {
+			diags.AddAttributeError(
+				path.Root("reboot_timeout"),
+				"Invalid Reboot Timeout",
+				fmt.Sprintf("reboot_timeout must be a positive duration such as 10m, got %q.", plan.RebootTimeout.ValueString()),
+			)
+			return
+		}
+	}
+
+	imagePath := plan.ImagePath.ValueString()
+	//#nosec G304 -- the image path is provided by the operator
+	image, err := os.ReadFile(imagePath)
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("image_path"),
+			"Error Reading Firmware Image",
+			fmt.Sprintf("Could not read the firmware image: %s", err),
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "Upgrading firmware", map[string]any{
+		"image_path": imagePath,
+		"version":    plan.Version.ValueString(),
+	})
+
+	_, err = r.client.UpgradeFirmware(ctx, &sdk.FirmwareUpgrade{
+		Image:         image,
+		FileName:      filepath.Base(imagePath),
+		TargetVersion: plan.Version.ValueString(),
+		RebootTimeout: rebootTimeout,
+	})
+	if err != nil {
+		diags.AddError(
+			"Error Upgrading Firmware",
+			fmt.Sprintf("Failed to upgrade the firmware to %s: %s", plan.Version.ValueString(), err),
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "Firmware upgraded")
+}
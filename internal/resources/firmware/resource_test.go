@@ -0,0 +1,144 @@
+package firmware_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	// Import the test helper package.
+	"github.com/brennoo/terraform-provider-hrui/internal/provider"
+)
+
+// TestAccFirmwareResource provides an acceptance test for the version guard
+// of the hrui_firmware resource: the switch already runs `version`, so the
+// image is not uploaded.
+func TestAccFirmwareResource(t *testing.T) {
+	imagePath := testAccPlaceholderImage(t)
+	providerFactories := provider.TestAccProtoV6ProviderFactories(t, "firmware_resource_test")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			// Step 1: Create the resource for the running version; the
+			// cassette has no upgrade.cgi interaction, so an upload fails
+			{
+				Config: testAccFirmwareResourceConfig(imagePath, testAccCurrentVersion(), "5m"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("hrui_firmware.test", "version", testAccCurrentVersion()),
+					resource.TestCheckResourceAttr("hrui_firmware.test", "image_path", imagePath),
+				),
+			},
+			// Destroying the resource leaves the firmware unchanged.
+		},
+	})
+}
+
+// TestAccFirmwareResource_Upgrade provides an acceptance test for an upgrade:
+// the image is uploaded, and the provider keeps polling until the switch
+// reports the new version. The first poll still sees the old version.
+func TestAccFirmwareResource_Upgrade(t *testing.T) {
+	imagePath := testAccFirmwareImage(t)
+	providerFactories := provider.TestAccProtoV6ProviderFactories(t, "firmware_resource_upgrade_test")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			// Step 1: Upgrade the switch and wait for the new version
+			{
+				Config: testAccFirmwareResourceConfig(imagePath, testAccTargetVersion(), "5m"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("hrui_firmware.test", "version", testAccTargetVersion()),
+				),
+			},
+		},
+	})
+}
+
+// TestAccFirmwareResource_Timeout provides an acceptance test for an upgrade
+// after which the switch keeps reporting the old version: the apply fails
+// once reboot_timeout has passed. A switch does not fail like this on
+// demand, so the test only replays its cassette.
+func TestAccFirmwareResource_Timeout(t *testing.T) {
+	if testAccLive() {
+		t.Skip("the firmware timeout test cannot be recorded")
+	}
+	imagePath := testAccPlaceholderImage(t)
+	providerFactories := provider.TestAccProtoV6ProviderFactories(t, "firmware_resource_timeout_test")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			// Step 1: The switch is checked once and still runs the old version
+			{
+				Config:      testAccFirmwareResourceConfig(imagePath, testAccTargetVersion(), "8s"),
+				ExpectError: regexp.MustCompile(`switch not ready within\s+8s:(?s).*expected\s+` + regexp.QuoteMeta(testAccTargetVersion())),
+			},
+		},
+	})
+}
+
+// testAccCurrentVersion returns the firmware version of the recorded switch.
+func testAccCurrentVersion() string {
+	if version := os.Getenv("HRUI_FW_VERSION"); version != "" {
+		return version
+	}
+	return "v1.9"
+}
+
+// testAccTargetVersion returns the firmware version the upgrade tests install,
+// HRUI_FIRMWARE_VERSION when recording, and otherwise the other firmware the
+// provider is tested against.
+func testAccTargetVersion() string {
+	if version := os.Getenv("HRUI_FIRMWARE_VERSION"); version != "" {
+		return version
+	}
+	if testAccCurrentVersion() == "v1.9.1" {
+		return "V1.9"
+	}
+	return "V1.9.1"
+}
+
+// testAccLive reports whether the test talks to a real switch.
+func testAccLive() bool {
+	mode := os.Getenv("VCR_MODE")
+	return mode == "record" || mode == "passthrough"
+}
+
+// testAccFirmwareImage returns the path of the firmware image to install.
+// Recording flashes the switch, so it needs a real image in
+// HRUI_FIRMWARE_IMAGE; replaying uploads a placeholder file.
+func testAccFirmwareImage(t *testing.T) string {
+	if image := os.Getenv("HRUI_FIRMWARE_IMAGE"); image != "" {
+		return image
+	}
+	if testAccLive() {
+		t.Skip("HRUI_FIRMWARE_IMAGE must be set to record the firmware upgrade test")
+	}
+	return testAccPlaceholderImage(t)
+}
+
+// testAccPlaceholderImage writes a placeholder firmware image for the replayed tests.
+func testAccPlaceholderImage(t *testing.T) string {
+	imagePath := filepath.Join(t.TempDir(), "firmware.bin")
+	if err := os.WriteFile(imagePath, []byte("HRUI firmware image"), 0o600); err != nil {
+		t.Fatalf("Failed to write firmware image: %v", err)
+	}
+	return imagePath
+}
+
+// testAccFirmwareResourceConfig generates the HCL for the hrui_firmware resource.
+func testAccFirmwareResourceConfig(imagePath, version, rebootTimeout string) string {
+	return fmt.Sprintf(`
+provider "hrui" {}
+
+resource "hrui_firmware" "test" {
+  image_path     = %q
+  version        = %q
+  reboot_timeout = %q
+}
+`, imagePath, version, rebootTimeout)
+}
@@ -6,5 +6,6 @@ import (
 
 // igmpSnoopingModel maps the schema to Go types.
 type igmpSnoopingModel struct {
-	Enabled types.Bool `tfsdk:"enabled"`
+	Enabled types.Bool   `tfsdk:"enabled"`
+	Device  types.String `tfsdk:"device"`
 }
@@ -21,7 +21,8 @@ var (
 
 // igmpSnoopingResource represents the global IGMP snooping configuration.
 type igmpSnoopingResource struct {
-	client *sdk.HRUIClient
+	client  *sdk.HRUIClient
+	devices *providerutil.Devices
 }
 
 // NewResource creates a new IGMP snooping resource.
@@ -39,6 +40,7 @@ func (r *igmpSnoopingResource) Schema(_ context.Context, _ resource.SchemaReques
 	resp.Schema = schema.Schema{
 		Description: "Manages global IGMP Snooping configuration.",
 		Attributes: map[string]schema.Attribute{
+			"device": providerutil.DeviceResourceAttribute(),
 			"enabled": schema.BoolAttribute{
 				Description: "Specifies whether IGMP snooping is enabled or disabled globally.",
 				Required:    true,
@@ -49,11 +51,17 @@ func (r *igmpSnoopingResource) Schema(_ context.Context, _ resource.SchemaReques
 
 // Configure sets the client from the provider data.
 func (r *igmpSnoopingResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	r.client = providerutil.ConfigureClient(req.ProviderData, &resp.Diagnostics)
+	r.devices = providerutil.ConfigureDevices(req.ProviderData, &resp.Diagnostics)
 }
 
 // Create enables or disables global IGMP Snooping.
 func (r *igmpSnoopingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	r.client = r.devices.Client(ctx, req.Plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer providerutil.KeepDevice(ctx, req.Plan, &resp.State, &resp.Diagnostics)
+
 	tflog.Debug(ctx, "Creating IGMP snooping settings")
 
 	var plan igmpSnoopingModel
@@ -92,6 +100,12 @@ func (r *igmpSnoopingResource) Create(ctx context.Context, req resource.CreateRe
 
 // Read synchronizes the Terraform state with the current global IGMP snooping configuration.
 func (r *igmpSnoopingResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	r.client = r.devices.Client(ctx, req.State, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer providerutil.KeepDevice(ctx, req.State, &resp.State, &resp.Diagnostics)
+
 	tflog.Debug(ctx, "Reading IGMP snooping settings")
 
 	var state igmpSnoopingModel
@@ -122,6 +136,12 @@ func (r *igmpSnoopingResource) Read(ctx context.Context, req resource.ReadReques
 
 // Update modifies the global IGMP snooping configuration.
 func (r *igmpSnoopingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	r.client = r.devices.Client(ctx, req.Plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer providerutil.KeepDevice(ctx, req.Plan, &resp.State, &resp.Diagnostics)
+
 	tflog.Debug(ctx, "Updating IGMP snooping settings")
 
 	var plan igmpSnoopingModel
@@ -160,6 +180,11 @@ func (r *igmpSnoopingResource) Update(ctx context.Context, req resource.UpdateRe
 
 // Delete disables global IGMP Snooping.
 func (r *igmpSnoopingResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	r.client = r.devices.Client(ctx, req.State, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	tflog.Debug(ctx, "Deleting IGMP snooping settings")
 
 	// Disable global IGMP snooping
@@ -177,6 +202,14 @@ func (r *igmpSnoopingResource) Delete(ctx context.Context, req resource.DeleteRe
 
 // ImportState imports an existing IGMP Snooping resource by fetching the current state.
 func (r *igmpSnoopingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	device, importID := providerutil.SplitImportID(req.ID)
+	r.client = r.devices.Get(ctx, device, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer providerutil.SetDevice(ctx, device, &resp.State, &resp.Diagnostics)
+	req.ID = importID
+
 	tflog.Debug(ctx, "Importing IGMP snooping settings", map[string]any{"id": req.ID})
 
 	config, err := r.client.FetchIGMPConfig(ctx)
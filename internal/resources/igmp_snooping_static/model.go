@@ -7,4 +7,5 @@ import (
 type igmpSnoopingStaticModel struct {
 	Port    types.String `tfsdk:"port"`
 	Enabled types.Bool   `tfsdk:"enabled"`
+	Device  types.String `tfsdk:"device"`
 }
@@ -2,6 +2,7 @@ package igmp_snooping_static
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -24,7 +25,8 @@ var (
 )
 
 type igmpSnoopingStaticResource struct {
-	client *sdk.HRUIClient
+	client  *sdk.HRUIClient
+	devices *providerutil.Devices
 }
 
 // New creates a new resource instance.
@@ -42,6 +44,7 @@ func (r *igmpSnoopingStaticResource) Schema(_ context.Context, _ resource.Schema
 	resp.Schema = schema.Schema{
 		Description: "Manages IGMP snooping static settings for a specific port.",
 		Attributes: map[string]schema.Attribute{
+			"device": providerutil.DeviceResourceAttribute(),
 			"port": schema.StringAttribute{
 				Description: "The port name for which IGMP snooping static configuration is managed.",
 				Required:    true,
@@ -59,11 +62,17 @@ func (r *igmpSnoopingStaticResource) Schema(_ context.Context, _ resource.Schema
 
 // Configure sets up the resource client.
 func (r *igmpSnoopingStaticResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	r.client = providerutil.ConfigureClient(req.ProviderData, &resp.Diagnostics)
+	r.devices = providerutil.ConfigureDevices(req.ProviderData, &resp.Diagnostics)
 }
 
 // Create enables IGMP snooping for a specific port.
 func (r *igmpSnoopingStaticResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	r.client = r.devices.Client(ctx, req.Plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer providerutil.KeepDevice(ctx, req.Plan, &resp.State, &resp.Diagnostics)
+
 	var plan igmpSnoopingStaticModel
 
 	// Retrieve the desired state from the plan
@@ -134,6 +143,12 @@ func (r *igmpSnoopingStaticResource) Create(ctx context.Context, req resource.Cr
 
 // Read retrieves the current IGMP snooping static configuration for a port.
 func (r *igmpSnoopingStaticResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	r.client = r.devices.Client(ctx, req.State, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer providerutil.KeepDevice(ctx, req.State, &resp.State, &resp.Diagnostics)
+
 	var state igmpSnoopingStaticModel
 
 	// Get current state
@@ -147,6 +162,11 @@ func (r *igmpSnoopingStaticResource) Read(ctx context.Context, req resource.Read
 
 	// Query the current IGMP snooping status for the specified port
 	enabled, err := r.client.GetPortIGMPSnoopingByName(ctx, state.Port.ValueString())
+	if errors.Is(err, sdk.ErrNotFound) {
+		tflog.Warn(ctx, "Port no longer exists on the device, removing from state", map[string]any{"port": state.Port.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading IGMP Snooping Static",
@@ -166,6 +186,12 @@ func (r *igmpSnoopingStaticResource) Read(ctx context.Context, req resource.Read
 
 // Update modifies the IGMP snooping static configuration for a specific port.
 func (r *igmpSnoopingStaticResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	r.client = r.devices.Client(ctx, req.Plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer providerutil.KeepDevice(ctx, req.Plan, &resp.State, &resp.Diagnostics)
+
 	var plan igmpSnoopingStaticModel
 
 	// Get the updated state from the plan
@@ -236,6 +262,11 @@ func (r *igmpSnoopingStaticResource) Update(ctx context.Context, req resource.Up
 
 // Delete disables IGMP snooping for a specific port.
 func (r *igmpSnoopingStaticResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	r.client = r.devices.Client(ctx, req.State, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var state igmpSnoopingStaticModel
 
 	// Retrieve the current state
@@ -262,6 +293,14 @@ func (r *igmpSnoopingStaticResource) Delete(ctx context.Context, req resource.De
 
 // ImportState imports an existing IGMP Snooping Static resource by port name.
 func (r *igmpSnoopingStaticResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	device, importID := providerutil.SplitImportID(req.ID)
+	r.client = r.devices.Get(ctx, device, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer providerutil.SetDevice(ctx, device, &resp.State, &resp.Diagnostics)
+	req.ID = importID
+
 	tflog.Debug(ctx, "Importing IGMP snooping static", map[string]any{"id": req.ID})
 
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("port"), req.ID)...)
@@ -0,0 +1,67 @@
+package ip_address_settings
+
+import (
+	"testing"
+	"time"
+
+	"github.com/brennoo/terraform-provider-hrui/internal/sdk"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRefreshCIDR(t *testing.T) {
+	settings := &sdk.IPAddressSettings{IPAddress: "192.168.1.100", Netmask: "255.255.255.0"}
+
+	tests := []struct {
+		name string
+		cidr types.String
+		want types.String
+	}{
+		{"imported", types.StringNull(), types.StringValue("192.168.1.100/24")},
+		{"unknown after apply", types.StringUnknown(), types.StringValue("192.168.1.100/24")},
+		{"unchanged", types.StringValue("192.168.1.100/24"), types.StringValue("192.168.1.100/24")},
+		{"changed on the device", types.StringValue("192.168.1.50/16"), types.StringValue("192.168.1.100/24")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, refreshCIDR(tt.cidr, settings))
+		})
+	}
+
+	assert.Equal(t, types.StringNull(), refreshCIDR(types.StringNull(), &sdk.IPAddressSettings{DHCPEnabled: true}),
+		"an address the switch does not report has no CIDR notation")
+}
+
+func TestPlannedCIDR(t *testing.T) {
+	assert.Equal(t, types.StringValue("10.0.0.2/8"), plannedCIDR(types.StringValue("10.0.0.2"), types.StringValue("255.0.0.0")))
+	assert.True(t, plannedCIDR(types.StringUnknown(), types.StringValue("255.0.0.0")).IsUnknown())
+	assert.True(t, plannedCIDR(types.StringValue("10.0.0.2"), types.StringNull()).IsUnknown())
+	assert.True(t, plannedCIDR(types.StringValue("10.0.0.2"), types.StringValue("255.0.255.0")).IsUnknown())
+}
+
+func TestReadyTimeout(t *testing.T) {
+	for _, value := range []types.String{types.StringNull(), types.StringUnknown()} {
+		var diags diag.Diagnostics
+		timeout, ok := readyTimeout(value, &diags)
+		require.True(t, ok)
+		assert.Equal(t, sdk.DefaultReadyTimeout, timeout)
+		assert.False(t, diags.HasError())
+	}
+
+	var diags diag.Diagnostics
+	timeout, ok := readyTimeout(types.StringValue("90s"), &diags)
+	require.True(t, ok)
+	assert.Equal(t, 90*time.Second, timeout)
+
+	for _, value := range []string{"soon", "0s", "-1m"} {
+		t.Run(value, func(t *testing.T) {
+			var diags diag.Diagnostics
+			_, ok := readyTimeout(types.StringValue(value), &diags)
+			require.False(t, ok)
+			require.True(t, diags.HasError())
+			assert.Equal(t, "Invalid Ready Timeout", diags.Errors()[0].Summary())
+		})
+	}
+}
@@ -9,4 +9,17 @@ type ipAddressModel struct {
 	IPAddress   types.String `tfsdk:"ip_address"`
 	Netmask     types.String `tfsdk:"netmask"`
 	Gateway     types.String `tfsdk:"gateway"`
+	CIDR        types.String `tfsdk:"cidr"`
+	IPv6        *ipv6Model   `tfsdk:"ipv6"`
+
+	ReadyTimeout types.String `tfsdk:"ready_timeout"`
+	Device       types.String `tfsdk:"device"`
+}
+
+type ipv6Model struct {
+	Autoconfig       types.Bool   `tfsdk:"autoconfig"`
+	Address          types.String `tfsdk:"address"`
+	PrefixLength     types.Int64  `tfsdk:"prefix_length"`
+	Gateway          types.String `tfsdk:"gateway"`
+	LinkLocalAddress types.String `tfsdk:"link_local_address"`
 }
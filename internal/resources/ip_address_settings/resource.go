@@ -2,25 +2,33 @@ package ip_address_settings
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
 	"github.com/brennoo/terraform-provider-hrui/internal/providerutil"
 	"github.com/brennoo/terraform-provider-hrui/internal/sdk"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &ipAddressResource{}
-	_ resource.ResourceWithConfigure   = &ipAddressResource{}
-	_ resource.ResourceWithImportState = &ipAddressResource{}
+	_ resource.Resource                   = &ipAddressResource{}
+	_ resource.ResourceWithConfigure      = &ipAddressResource{}
+	_ resource.ResourceWithImportState    = &ipAddressResource{}
+	_ resource.ResourceWithModifyPlan     = &ipAddressResource{}
+	_ resource.ResourceWithValidateConfig = &ipAddressResource{}
 )
 
 // ipAddressResource is the resource implementation.
 type ipAddressResource struct {
-	client *sdk.HRUIClient
+	client  *sdk.HRUIClient
+	devices *providerutil.Devices
 }
 
 // NewResource is a helper function to simplify the provider implementation.
@@ -30,7 +38,7 @@ func NewResource() resource.Resource {
 
 // Configure adds the provider configured client to the resource.
 func (r *ipAddressResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	r.client = providerutil.ConfigureClient(req.ProviderData, &resp.Diagnostics)
+	r.devices = providerutil.ConfigureDevices(req.ProviderData, &resp.Diagnostics)
 }
 
 // Metadata defines the schema type name.
@@ -40,6 +48,12 @@ func (r *ipAddressResource) Metadata(ctx context.Context, req resource.MetadataR
 
 // Create operation for IP Address Settings.
 func (r *ipAddressResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	r.client = r.devices.Client(ctx, req.Plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer providerutil.KeepDevice(ctx, req.Plan, &resp.State, &resp.Diagnostics)
+
 	var data ipAddressModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
@@ -48,17 +62,16 @@ func (r *ipAddressResource) Create(ctx context.Context, req resource.CreateReque
 
 	tflog.Debug(ctx, "Creating IP address settings")
 
-	settings := &sdk.IPAddressSettings{
-		DHCPEnabled: data.DHCPEnabled.ValueBool(),
-		IPAddress:   data.IPAddress.ValueString(),
-		Netmask:     data.Netmask.ValueString(),
-		Gateway:     data.Gateway.ValueString(),
-	}
-
-	if err := r.client.SetIPAddressSettings(ctx, settings); err != nil {
+	if err := r.apply(ctx, &data, req.Config, &resp.Diagnostics); err != nil {
+		if providerutil.AddUnsupportedFeatureError(&resp.Diagnostics, err) {
+			return
+		}
 		resp.Diagnostics.AddError("Error Creating IP Address Settings", fmt.Sprintf("Unable to create HRUI IP address settings, got error: %s", err))
 		return
 	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	// Fetch updated data to sync with the latest state
 	updatedSettings, err := r.client.GetIPAddressSettings(ctx)
@@ -71,6 +84,16 @@ func (r *ipAddressResource) Create(ctx context.Context, req resource.CreateReque
 	data.IPAddress = types.StringValue(updatedSettings.IPAddress)
 	data.Netmask = types.StringValue(updatedSettings.Netmask)
 	data.Gateway = types.StringValue(updatedSettings.Gateway)
+	if data.CIDR.IsUnknown() {
+		data.CIDR = refreshCIDR(types.StringNull(), updatedSettings)
+	}
+	if err := r.readIPv6(ctx, &data); err != nil {
+		if providerutil.AddUnsupportedFeatureError(&resp.Diagnostics, err) {
+			return
+		}
+		resp.Diagnostics.AddError("Error Reading IPv6 Settings", fmt.Sprintf("Unable to read the latest HRUI IPv6 settings, got error: %s", err))
+		return
+	}
 
 	// Set state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -80,8 +103,20 @@ func (r *ipAddressResource) Create(ctx context.Context, req resource.CreateReque
 
 // Read function for IP Address settings.
 func (r *ipAddressResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	r.client = r.devices.Client(ctx, req.State, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer providerutil.KeepDevice(ctx, req.State, &resp.State, &resp.Diagnostics)
+
 	tflog.Debug(ctx, "Reading IP address settings")
 
+	var state ipAddressModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	settings, err := r.client.GetIPAddressSettings(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError("Error Reading IP Address Settings", fmt.Sprintf("Unable to read the latest HRUI IP address settings, got error: %s", err))
@@ -89,11 +124,23 @@ func (r *ipAddressResource) Read(ctx context.Context, req resource.ReadRequest,
 	}
 
 	var data ipAddressModel
+	data.ReadyTimeout = state.ReadyTimeout
+	data.CIDR = refreshCIDR(state.CIDR, settings)
 	data.DHCPEnabled = types.BoolValue(settings.DHCPEnabled)
 	data.IPAddress = types.StringValue(settings.IPAddress)
 	data.Netmask = types.StringValue(settings.Netmask)
 	data.Gateway = types.StringValue(settings.Gateway)
 
+	// IPv6 settings are only read if they are managed, as firmware 1.9 and 1.9.1 are not known to have them.
+	data.IPv6 = state.IPv6
+	if err := r.readIPv6(ctx, &data); err != nil {
+		if providerutil.AddUnsupportedFeatureError(&resp.Diagnostics, err) {
+			return
+		}
+		resp.Diagnostics.AddError("Error Reading IPv6 Settings", fmt.Sprintf("Unable to read the latest HRUI IPv6 settings, got error: %s", err))
+		return
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 
 	tflog.Debug(ctx, "IP address settings read")
@@ -101,6 +148,12 @@ func (r *ipAddressResource) Read(ctx context.Context, req resource.ReadRequest,
 
 // Update function for IP Address settings.
 func (r *ipAddressResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	r.client = r.devices.Client(ctx, req.Plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer providerutil.KeepDevice(ctx, req.Plan, &resp.State, &resp.Diagnostics)
+
 	var data ipAddressModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
@@ -109,20 +162,19 @@ func (r *ipAddressResource) Update(ctx context.Context, req resource.UpdateReque
 
 	tflog.Debug(ctx, "Updating IP address settings")
 
-	settings := &sdk.IPAddressSettings{
-		DHCPEnabled: data.DHCPEnabled.ValueBool(),
-		IPAddress:   data.IPAddress.ValueString(),
-		Netmask:     data.Netmask.ValueString(),
-		Gateway:     data.Gateway.ValueString(),
-	}
-
-	if err := r.client.SetIPAddressSettings(ctx, settings); err != nil {
+	if err := r.apply(ctx, &data, req.Config, &resp.Diagnostics); err != nil {
+		if providerutil.AddUnsupportedFeatureError(&resp.Diagnostics, err) {
+			return
+		}
 		resp.Diagnostics.AddError(
 			"Error Updating IP Address Settings",
 			fmt.Sprintf("Failed to update IP address settings: %s", err),
 		)
 		return
 	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	updatedSettings, err := r.client.GetIPAddressSettings(ctx)
 	if err != nil {
@@ -136,6 +188,19 @@ func (r *ipAddressResource) Update(ctx context.Context, req resource.UpdateReque
 	data.IPAddress = types.StringValue(updatedSettings.IPAddress)
 	data.Netmask = types.StringValue(updatedSettings.Netmask)
 	data.Gateway = types.StringValue(updatedSettings.Gateway)
+	if data.CIDR.IsUnknown() {
+		data.CIDR = refreshCIDR(types.StringNull(), updatedSettings)
+	}
+	if err := r.readIPv6(ctx, &data); err != nil {
+		if providerutil.AddUnsupportedFeatureError(&resp.Diagnostics, err) {
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading IPv6 Settings",
+			fmt.Sprintf("Failed to fetch updated IPv6 settings: %s", err),
+		)
+		return
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 
@@ -144,6 +209,11 @@ func (r *ipAddressResource) Update(ctx context.Context, req resource.UpdateReque
 
 // Now implement the missing Delete method to ensure compliance with the Resource interface.
 func (r *ipAddressResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	r.client = r.devices.Client(ctx, req.State, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	tflog.Debug(ctx, "Deleting IP address settings")
 
 	// Handle the deletion logic if needed, or leave it empty if the resource cannot be deleted via API
@@ -154,6 +224,14 @@ func (r *ipAddressResource) Delete(ctx context.Context, req resource.DeleteReque
 }
 
 func (r *ipAddressResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	device, importID := providerutil.SplitImportID(req.ID)
+	r.client = r.devices.Get(ctx, device, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer providerutil.SetDevice(ctx, device, &resp.State, &resp.Diagnostics)
+	req.ID = importID
+
 	tflog.Debug(ctx, "Importing IP address settings", map[string]any{"id": req.ID})
 
 	// ImportState is not needed for singleton resources
@@ -168,5 +246,252 @@ func (r *ipAddressResource) ImportState(ctx context.Context, req resource.Import
 	data.IPAddress = types.StringValue(settings.IPAddress)
 	data.Netmask = types.StringValue(settings.Netmask)
 	data.Gateway = types.StringValue(settings.Gateway)
+	data.CIDR = refreshCIDR(types.StringNull(), settings)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
+
+// apply writes the IP address settings of data to the device. If the switch moves to another
+// address, the client follows it there and a warning reminds to update the provider configuration.
+// With DHCP, the configured ip_address is the address the switch is expected to get; the planned
+// value may be the previous static address kept from the state.
+func (r *ipAddressResource) apply(ctx context.Context, data *ipAddressModel, config tfsdk.Config, diags *diag.Diagnostics) error {
+	timeout, ok := readyTimeout(data.ReadyTimeout, diags)
+	if !ok {
+		return nil
+	}
+
+	if !data.CIDR.IsNull() && !data.CIDR.IsUnknown() {
+		ipAddress, netmask, err := sdk.SplitCIDR(data.CIDR.ValueString())
+		if err != nil {
+			return err
+		}
+		data.IPAddress = types.StringValue(ipAddress)
+		data.Netmask = types.StringValue(netmask)
+	}
+
+	settings := &sdk.IPAddressSettings{
+		DHCPEnabled: data.DHCPEnabled.ValueBool(),
+		IPAddress:   data.IPAddress.ValueString(),
+		Netmask:     data.Netmask.ValueString(),
+		Gateway:     data.Gateway.ValueString(),
+	}
+
+	var dhcpAddress types.String
+	if settings.DHCPEnabled {
+		diags.Append(config.GetAttribute(ctx, path.Root("ip_address"), &dhcpAddress)...)
+		if diags.HasError() {
+			return nil
+		}
+	}
+
+	previousURL := r.client.BaseURL()
+	err := r.client.ChangeIPAddressSettings(ctx, settings, dhcpAddress.ValueString(), timeout, 0)
+	if errors.Is(err, sdk.ErrDHCPAddressUnknown) {
+		diags.AddAttributeError(
+			path.Root("ip_address"),
+			"DHCP Address Required",
+			"Enabling DHCP moves the switch to an address the provider cannot know in advance. Reserve an address for "+
+				"the switch in the DHCP server and set ip_address to it, so the provider can follow the switch there. Nothing was changed.",
+		)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if r.client.BaseURL() != previousURL {
+		diags.AddWarning(
+			"Switch Address Changed",
+			fmt.Sprintf("The switch moved from %s to %s. The rest of this run uses the new address; "+
+				"update the provider url or the device entry before the next run.", previousURL, r.client.BaseURL()),
+		)
+	}
+
+	if data.IPv6 != nil {
+		if err := r.client.SetIPv6Settings(ctx, ipv6Settings(data.IPv6)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ModifyPlan derives ip_address and netmask from cidr, or cidr from them if it is not configured,
+// and checks the planned static settings, so that an address the switch would be unreachable at is
+// rejected before it is applied.
+func (r *ipAddressResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var dhcpEnabled types.Bool
+	var ipAddress, netmask, gateway, cidr types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("dhcp_enabled"), &dhcpEnabled)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("ip_address"), &ipAddress)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("netmask"), &netmask)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("gateway"), &gateway)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("cidr"), &cidr)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	addressPath := path.Root("ip_address")
+	if cidr.IsNull() {
+		// cidr reports the configured ip_address and netmask.
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("cidr"), plannedCIDR(ipAddress, netmask))...)
+	} else {
+		if cidr.IsUnknown() {
+			return
+		}
+		cidrAddress, cidrNetmask, err := sdk.SplitCIDR(cidr.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("cidr"), "Invalid CIDR Address", err.Error()+".")
+			return
+		}
+		ipAddress, netmask = types.StringValue(cidrAddress), types.StringValue(cidrNetmask)
+		addressPath = path.Root("cidr")
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("ip_address"), ipAddress)...)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("netmask"), netmask)...)
+	}
+
+	// With DHCP the address settings are not used.
+	if dhcpEnabled.ValueBool() || ipAddress.IsUnknown() || netmask.IsUnknown() || gateway.IsUnknown() {
+		return
+	}
+
+	settings := &sdk.IPAddressSettings{
+		IPAddress: ipAddress.ValueString(),
+		Netmask:   netmask.ValueString(),
+		Gateway:   gateway.ValueString(),
+	}
+	if err := settings.Validate(); err != nil {
+		resp.Diagnostics.AddAttributeError(addressPath, "Invalid IP Address Settings", err.Error()+".")
+	}
+}
+
+// ValidateConfig checks ready_timeout and the IPv6 settings with the rules of the switch, once they
+// are known, so that invalid values fail before anything is applied.
+func (r *ipAddressResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config ipAddressModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readyTimeout(config.ReadyTimeout, &resp.Diagnostics)
+
+	if config.IPv6 == nil {
+		return
+	}
+
+	ipv6 := config.IPv6
+	if ipv6.Autoconfig.IsUnknown() || ipv6.Address.IsUnknown() || ipv6.PrefixLength.IsUnknown() || ipv6.Gateway.IsUnknown() {
+		return
+	}
+
+	if ipv6.Autoconfig.ValueBool() {
+		if !ipv6.Address.IsNull() || !ipv6.PrefixLength.IsNull() || !ipv6.Gateway.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("ipv6"),
+				"Invalid IPv6 Settings",
+				"address, prefix_length and gateway are learned from router advertisements and cannot be set when autoconfig is enabled.",
+			)
+		}
+		return
+	}
+
+	if err := ipv6Settings(ipv6).Validate(); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("ipv6"), "Invalid IPv6 Settings", err.Error()+".")
+	}
+}
+
+// readIPv6 refreshes the IPv6 settings of data from the device if they are managed.
+func (r *ipAddressResource) readIPv6(ctx context.Context, data *ipAddressModel) error {
+	if data.IPv6 == nil {
+		return nil
+	}
+
+	settings, err := r.client.GetIPv6Settings(ctx)
+	if err != nil {
+		return err
+	}
+
+	data.IPv6 = &ipv6Model{
+		Autoconfig:       types.BoolValue(settings.Autoconfig),
+		Address:          optionalString(settings.Address),
+		PrefixLength:     types.Int64Null(),
+		Gateway:          optionalString(settings.Gateway),
+		LinkLocalAddress: optionalString(settings.LinkLocalAddress),
+	}
+	if settings.PrefixLength > 0 {
+		data.IPv6.PrefixLength = types.Int64Value(int64(settings.PrefixLength))
+	}
+	return nil
+}
+
+// ipv6Settings converts the IPv6 attributes to the SDK settings.
+func ipv6Settings(ipv6 *ipv6Model) *sdk.IPv6Settings {
+	return &sdk.IPv6Settings{
+		Autoconfig:   ipv6.Autoconfig.ValueBool(),
+		Address:      ipv6.Address.ValueString(),
+		PrefixLength: int(ipv6.PrefixLength.ValueInt64()),
+		Gateway:      ipv6.Gateway.ValueString(),
+	}
+}
+
+// readyTimeout parses ready_timeout, which defaults to sdk.DefaultReadyTimeout. It adds an error
+// diagnostic and returns false if the value is not a positive duration.
+func readyTimeout(value types.String, diags *diag.Diagnostics) (time.Duration, bool) {
+	if value.IsNull() || value.IsUnknown() {
+		return sdk.DefaultReadyTimeout, true
+	}
+
+	timeout, err := time.ParseDuration(value.ValueString())
+	if err != nil || timeout <= 0 {
+		diags.AddAttributeError(
+			path.Root("ready_timeout"),
+			"Invalid Ready Timeout",
+			fmt.Sprintf("ready_timeout must be a positive duration such as 2m, got %q.", value.ValueString()),
+		)
+		return 0, false
+	}
+	return timeout, true
+}
+
+// plannedCIDR returns the planned ip_address and netmask in CIDR notation, or an unknown value if
+// they are not known yet or cannot be combined; cidr is then set from the device after the apply.
+func plannedCIDR(ipAddress, netmask types.String) types.String {
+	if ipAddress.IsNull() || ipAddress.IsUnknown() || netmask.IsNull() || netmask.IsUnknown() {
+		return types.StringUnknown()
+	}
+
+	cidr, err := sdk.JoinCIDR(ipAddress.ValueString(), netmask.ValueString())
+	if err != nil {
+		return types.StringUnknown()
+	}
+	return types.StringValue(cidr)
+}
+
+// refreshCIDR returns cidr, or the address of settings in CIDR notation if cidr is null or the
+// address changed on the device. It returns null if the address of settings is not valid.
+func refreshCIDR(cidr types.String, settings *sdk.IPAddressSettings) types.String {
+	if !cidr.IsNull() && !cidr.IsUnknown() {
+		ipAddress, netmask, err := sdk.SplitCIDR(cidr.ValueString())
+		if err == nil && ipAddress == settings.IPAddress && netmask == settings.Netmask {
+			return cidr
+		}
+	}
+
+	current, err := sdk.JoinCIDR(settings.IPAddress, settings.Netmask)
+	if err != nil {
+		return types.StringNull()
+	}
+	return types.StringValue(current)
+}
+
+// optionalString returns a null string for fields the switch leaves empty.
+func optionalString(value string) types.String {
+	if value == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(value)
+}
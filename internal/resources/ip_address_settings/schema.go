@@ -3,17 +3,24 @@ package ip_address_settings
 import (
 	"context"
 
+	"github.com/brennoo/terraform-provider-hrui/internal/providerutil"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 )
 
 func (r *ipAddressResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Configures IP address settings.",
 		Attributes: map[string]schema.Attribute{
+			"device": providerutil.DeviceResourceAttribute(),
 			"dhcp_enabled": schema.BoolAttribute{
 				Optional: true,
 				Computed: true,
@@ -28,7 +35,17 @@ func (r *ipAddressResource) Schema(ctx context.Context, req resource.SchemaReque
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
-				Description: "The IP address of the HRUI switch.",
+				Description: "The IP address of the HRUI switch. With DHCP enabled, the address the DHCP server is expected to assign to the switch.",
+			},
+			"cidr": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				MarkdownDescription: "The IP address and prefix length of the HRUI switch in CIDR notation (e.g. `192.168.1.100/24`). " +
+					"Sets `ip_address` and `netmask`, which cannot be configured together with it. " +
+					"If not configured, it reports `ip_address` and `netmask` in CIDR notation.",
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("ip_address"), path.MatchRoot("netmask")),
+				},
 			},
 			"netmask": schema.StringAttribute{
 				Optional: true,
@@ -46,6 +63,50 @@ func (r *ipAddressResource) Schema(ctx context.Context, req resource.SchemaReque
 				},
 				Description: "The gateway of the HRUI switch.",
 			},
+			"ipv6": schema.SingleNestedAttribute{
+				Optional: true,
+				MarkdownDescription: "IPv6 settings of the management interface, on firmware that supports them. " +
+					"Firmware 1.9 and 1.9.1 are not known to, so on them setting the block reports an unsupported feature error. " +
+					"IPv6 is left unchanged if omitted.",
+				Attributes: map[string]schema.Attribute{
+					"autoconfig": schema.BoolAttribute{
+						Optional:            true,
+						Computed:            true,
+						Default:             booldefault.StaticBool(false),
+						MarkdownDescription: "Whether the address, prefix length and gateway are learned from router advertisements. Defaults to `false`.",
+					},
+					"address": schema.StringAttribute{
+						Optional:            true,
+						Computed:            true,
+						MarkdownDescription: "The global or unique local IPv6 address of the HRUI switch. Required unless `autoconfig` is enabled.",
+					},
+					"prefix_length": schema.Int64Attribute{
+						Optional:            true,
+						Computed:            true,
+						MarkdownDescription: "The prefix length of `address`. Required unless `autoconfig` is enabled.",
+						Validators: []validator.Int64{
+							int64validator.Between(1, 128),
+						},
+					},
+					"gateway": schema.StringAttribute{
+						Optional:            true,
+						Computed:            true,
+						MarkdownDescription: "The IPv6 gateway of the HRUI switch, either link-local or inside the prefix of `address`.",
+					},
+					"link_local_address": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "The link-local address of the HRUI switch, derived from its MAC address.",
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+				},
+			},
+			"ready_timeout": schema.StringAttribute{
+				Optional: true,
+				MarkdownDescription: "How long to wait for the switch to answer at its new address when `ip_address` or `dhcp_enabled` changes, " +
+					"as a Go duration string (e.g. `2m`). The change is only saved and stored in the state once the switch answers. Defaults to `5m`.",
+			},
 		},
 	}
 }
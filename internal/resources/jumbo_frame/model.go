@@ -6,5 +6,6 @@ import (
 
 // jumboFrameModel represents the Terraform resource data model for Jumbo Frame settings.
 type jumboFrameModel struct {
-	Size types.Int64 `tfsdk:"size"`
+	Size   types.Int64  `tfsdk:"size"`
+	Device types.String `tfsdk:"device"`
 }
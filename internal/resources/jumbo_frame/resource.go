@@ -21,7 +21,8 @@ var (
 
 // jumboFrameResource is the implementation of the Jumbo Frame Terraform resource.
 type jumboFrameResource struct {
-	client *sdk.HRUIClient
+	client  *sdk.HRUIClient
+	devices *providerutil.Devices
 }
 
 // NewResource creates a new instance of the Jumbo Frame resource.
@@ -39,6 +40,7 @@ func (r *jumboFrameResource) Schema(ctx context.Context, req resource.SchemaRequ
 	resp.Schema = schema.Schema{
 		Description: "Configures jumbo frame settings.",
 		Attributes: map[string]schema.Attribute{
+			"device": providerutil.DeviceResourceAttribute(),
 			"size": schema.Int64Attribute{
 				Required:    true,
 				Description: "Size of the Jumbo Frame in bytes. Valid options are 1522, 1536, 1552, 9216, and 16383.",
@@ -49,11 +51,17 @@ func (r *jumboFrameResource) Schema(ctx context.Context, req resource.SchemaRequ
 
 // Configure assigns the provider-configured client to the resource.
 func (r *jumboFrameResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	r.client = providerutil.ConfigureClient(req.ProviderData, &resp.Diagnostics)
+	r.devices = providerutil.ConfigureDevices(req.ProviderData, &resp.Diagnostics)
 }
 
 // Create sets the initial Jumbo Frame size.
 func (r *jumboFrameResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	r.client = r.devices.Client(ctx, req.Plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer providerutil.KeepDevice(ctx, req.Plan, &resp.State, &resp.Diagnostics)
+
 	tflog.Debug(ctx, "Creating jumbo frame settings")
 
 	// Parse the plan (input configuration from the user)
@@ -65,6 +73,9 @@ func (r *jumboFrameResource) Create(ctx context.Context, req resource.CreateRequ
 
 	appliedSize, err := r.client.SetJumboFrame(ctx, int(plan.Size.ValueInt64()))
 	if err != nil {
+		if providerutil.AddUnsupportedFeatureError(&resp.Diagnostics, err) {
+			return
+		}
 		resp.Diagnostics.AddError(
 			"Error Creating Jumbo Frame",
 			fmt.Sprintf("Failed to set Jumbo Frame size: %s", err),
@@ -82,6 +93,12 @@ func (r *jumboFrameResource) Create(ctx context.Context, req resource.CreateRequ
 
 // Read retrieves the current Jumbo Frame size from the device and updates the state.
 func (r *jumboFrameResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	r.client = r.devices.Client(ctx, req.State, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer providerutil.KeepDevice(ctx, req.State, &resp.State, &resp.Diagnostics)
+
 	tflog.Debug(ctx, "Reading jumbo frame settings")
 
 	// Parse the state (current resource state in Terraform)
@@ -110,6 +127,12 @@ func (r *jumboFrameResource) Read(ctx context.Context, req resource.ReadRequest,
 
 // Update changes the Jumbo Frame size to the new value in the plan.
 func (r *jumboFrameResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	r.client = r.devices.Client(ctx, req.Plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer providerutil.KeepDevice(ctx, req.Plan, &resp.State, &resp.Diagnostics)
+
 	tflog.Debug(ctx, "Updating jumbo frame settings")
 
 	// Parse the plan (new configuration)
@@ -121,6 +144,9 @@ func (r *jumboFrameResource) Update(ctx context.Context, req resource.UpdateRequ
 
 	appliedSize, err := r.client.SetJumboFrame(ctx, int(plan.Size.ValueInt64()))
 	if err != nil {
+		if providerutil.AddUnsupportedFeatureError(&resp.Diagnostics, err) {
+			return
+		}
 		resp.Diagnostics.AddError(
 			"Error Updating Jumbo Frame",
 			fmt.Sprintf("Failed to update Jumbo Frame size: %s", err),
@@ -138,6 +164,11 @@ func (r *jumboFrameResource) Update(ctx context.Context, req resource.UpdateRequ
 
 // Delete resets the Jumbo Frame size to its default (if applicable).
 func (r *jumboFrameResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	r.client = r.devices.Client(ctx, req.State, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	tflog.Debug(ctx, "Deleting jumbo frame settings")
 
 	defaultFrameSize := 16383
@@ -154,6 +185,14 @@ func (r *jumboFrameResource) Delete(ctx context.Context, req resource.DeleteRequ
 
 // ImportState imports an existing Jumbo Frame resource by fetching the current state.
 func (r *jumboFrameResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	device, importID := providerutil.SplitImportID(req.ID)
+	r.client = r.devices.Get(ctx, device, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer providerutil.SetDevice(ctx, device, &resp.State, &resp.Diagnostics)
+	req.ID = importID
+
 	tflog.Debug(ctx, "Importing jumbo frame settings", map[string]any{"id": req.ID})
 
 	jumboFrame, err := r.client.GetJumboFrame(ctx)
@@ -9,4 +9,5 @@ type loopProtocolModel struct {
 	LoopFunction types.String `tfsdk:"loop_function"`
 	IntervalTime types.Int64  `tfsdk:"interval_time"`
 	RecoverTime  types.Int64  `tfsdk:"recover_time"`
+	Device       types.String `tfsdk:"device"`
 }
@@ -25,7 +25,8 @@ var (
 
 // loopProtocolResource implements the resource for Loop Protocol configuration.
 type loopProtocolResource struct {
-	client *sdk.HRUIClient
+	client  *sdk.HRUIClient
+	devices *providerutil.Devices
 }
 
 // NewResource instantiates the resource.
@@ -48,6 +49,7 @@ func (r *loopProtocolResource) Schema(_ context.Context, _ resource.SchemaReques
 	resp.Schema = schema.Schema{
 		Description: "Manages loop protocol settings.",
 		Attributes: map[string]schema.Attribute{
+			"device": providerutil.DeviceResourceAttribute(),
 			"loop_function": schema.StringAttribute{
 				Description: "Specifies the loop function mode. Valid options are 'Off', 'Loop Detection', 'Loop Prevention', and 'Spanning Tree'.",
 				Optional:    true,
@@ -123,11 +125,17 @@ func (m *clearTimingIfIrrelevant) PlanModifyInt64(ctx context.Context, req planm
 
 // Configure sets the SDK client for the resource.
 func (r *loopProtocolResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	r.client = providerutil.ConfigureClient(req.ProviderData, &resp.Diagnostics)
+	r.devices = providerutil.ConfigureDevices(req.ProviderData, &resp.Diagnostics)
 }
 
 // Create provisions or initializes the resource with the specified settings.
 func (r *loopProtocolResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	r.client = r.devices.Client(ctx, req.Plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer providerutil.KeepDevice(ctx, req.Plan, &resp.State, &resp.Diagnostics)
+
 	tflog.Debug(ctx, "Creating loop protocol settings")
 
 	var plan loopProtocolModel
@@ -187,6 +195,12 @@ func (r *loopProtocolResource) Create(ctx context.Context, req resource.CreateRe
 
 // Read synchronizes the Terraform state with current API schema settings.
 func (r *loopProtocolResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	r.client = r.devices.Client(ctx, req.State, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer providerutil.KeepDevice(ctx, req.State, &resp.State, &resp.Diagnostics)
+
 	tflog.Debug(ctx, "Reading loop protocol settings")
 
 	var state loopProtocolModel
@@ -222,6 +236,12 @@ func (r *loopProtocolResource) Read(ctx context.Context, req resource.ReadReques
 
 // Update modifies the existing resource configuration.
 func (r *loopProtocolResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	r.client = r.devices.Client(ctx, req.Plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer providerutil.KeepDevice(ctx, req.Plan, &resp.State, &resp.Diagnostics)
+
 	tflog.Debug(ctx, "Updating loop protocol settings")
 
 	var plan loopProtocolModel
@@ -281,6 +301,11 @@ func (r *loopProtocolResource) Update(ctx context.Context, req resource.UpdateRe
 
 // Delete deactivates the protocol and removes the resource from state.
 func (r *loopProtocolResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	r.client = r.devices.Client(ctx, req.State, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	tflog.Debug(ctx, "Deleting loop protocol settings")
 
 	// Use an empty slice since port statuses are not handled.
@@ -298,6 +323,14 @@ func (r *loopProtocolResource) Delete(ctx context.Context, req resource.DeleteRe
 
 // ImportState imports an existing Loop Protocol resource by fetching the current state.
 func (r *loopProtocolResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	device, importID := providerutil.SplitImportID(req.ID)
+	r.client = r.devices.Get(ctx, device, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer providerutil.SetDevice(ctx, device, &resp.State, &resp.Diagnostics)
+	req.ID = importID
+
 	tflog.Debug(ctx, "Importing loop protocol settings", map[string]any{"id": req.ID})
 
 	loopProtocol, err := r.client.GetLoopProtocol(ctx)
@@ -0,0 +1,11 @@
+package mac_aging
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// macAgingModel represents the Terraform resource data model for the MAC address aging time.
+type macAgingModel struct {
+	AgingTime types.Int64  `tfsdk:"aging_time"`
+	Device    types.String `tfsdk:"device"`
+}
@@ -0,0 +1,220 @@
+package mac_aging
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/brennoo/terraform-provider-hrui/internal/providerutil"
+	"github.com/brennoo/terraform-provider-hrui/internal/sdk"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the required interfaces.
+var (
+	_ resource.Resource                = &macAgingResource{}
+	_ resource.ResourceWithConfigure   = &macAgingResource{}
+	_ resource.ResourceWithImportState = &macAgingResource{}
+)
+
+// macAgingResource is the implementation of the MAC address aging Terraform resource.
+type macAgingResource struct {
+	client  *sdk.HRUIClient
+	devices *providerutil.Devices
+}
+
+// NewResource creates a new instance of the MAC address aging resource.
+func NewResource() resource.Resource {
+	return &macAgingResource{}
+}
+
+// Metadata sets the resource name.
+func (r *macAgingResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_mac_aging"
+}
+
+// Schema defines the schema for the MAC address aging resource.
+func (r *macAgingResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Configures the MAC address aging time.",
+		MarkdownDescription: "Configures how long dynamically learned entries stay in the MAC address table without traffic from their source. " +
+			"Destroying the resource restores the default of 300 seconds. " +
+			"Requires firmware with an aging time setting. Firmware 1.9 and 1.9.1 are not known to have one, so on them the resource reports an unsupported feature error.",
+		Attributes: map[string]schema.Attribute{
+			"device": providerutil.DeviceResourceAttribute(),
+			"aging_time": schema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: fmt.Sprintf("The aging time in seconds (%d-%d).", sdk.MinMACAgingTime, sdk.MaxMACAgingTime),
+				Validators: []validator.Int64{
+					int64validator.Between(sdk.MinMACAgingTime, sdk.MaxMACAgingTime),
+				},
+			},
+		},
+	}
+}
+
+// Configure assigns the provider-configured client to the resource.
+func (r *macAgingResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.devices = providerutil.ConfigureDevices(req.ProviderData, &resp.Diagnostics)
+}
+
+// Create sets the MAC address aging time.
+func (r *macAgingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	r.client = r.devices.Client(ctx, req.Plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer providerutil.KeepDevice(ctx, req.Plan, &resp.State, &resp.Diagnostics)
+
+	var plan macAgingModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating MAC aging time", map[string]any{"aging_time": plan.AgingTime.ValueInt64()})
+
+	if err := r.client.SetMACAgingTime(ctx, int(plan.AgingTime.ValueInt64())); err != nil {
+		if providerutil.AddUnsupportedFeatureError(&resp.Diagnostics, err) {
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Creating MAC Aging Time",
+			fmt.Sprintf("Failed to set MAC aging time: %s", err),
+		)
+		return
+	}
+
+	plan.AgingTime = r.readBack(ctx, "Error Creating MAC Aging Time", &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read retrieves the MAC address aging time from the device and updates the state.
+func (r *macAgingResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	r.client = r.devices.Client(ctx, req.State, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer providerutil.KeepDevice(ctx, req.State, &resp.State, &resp.Diagnostics)
+
+	var state macAgingModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	agingTime, err := r.client.GetMACAgingTime(ctx)
+	if err != nil {
+		if providerutil.AddUnsupportedFeatureError(&resp.Diagnostics, err) {
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading MAC Aging Time",
+			fmt.Sprintf("Failed to read MAC aging time: %s", err),
+		)
+		return
+	}
+
+	state.AgingTime = types.Int64Value(int64(agingTime))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update changes the MAC address aging time to the new value in the plan.
+func (r *macAgingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	r.client = r.devices.Client(ctx, req.Plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer providerutil.KeepDevice(ctx, req.Plan, &resp.State, &resp.Diagnostics)
+
+	var plan macAgingModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Updating MAC aging time", map[string]any{"aging_time": plan.AgingTime.ValueInt64()})
+
+	if err := r.client.SetMACAgingTime(ctx, int(plan.AgingTime.ValueInt64())); err != nil {
+		if providerutil.AddUnsupportedFeatureError(&resp.Diagnostics, err) {
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Updating MAC Aging Time",
+			fmt.Sprintf("Failed to update MAC aging time: %s", err),
+		)
+		return
+	}
+
+	plan.AgingTime = r.readBack(ctx, "Error Updating MAC Aging Time", &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// readBack reads the MAC aging time after it was set. On unknown firmware without the aging
+// setting, the missing setting is only noticed when reading the page.
+func (r *macAgingResource) readBack(ctx context.Context, summary string, diags *diag.Diagnostics) types.Int64 {
+	agingTime, err := r.client.GetMACAgingTime(ctx)
+	if err != nil {
+		if !providerutil.AddUnsupportedFeatureError(diags, err) {
+			diags.AddError(summary, fmt.Sprintf("Failed to read back MAC aging time: %s", err))
+		}
+		return types.Int64Null()
+	}
+	return types.Int64Value(int64(agingTime))
+}
+
+// Delete restores the default MAC address aging time.
+func (r *macAgingResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	r.client = r.devices.Client(ctx, req.State, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Deleting MAC aging time")
+
+	if err := r.client.SetMACAgingTime(ctx, sdk.DefaultMACAgingTime); err != nil {
+		if providerutil.AddUnsupportedFeatureError(&resp.Diagnostics, err) {
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Deleting MAC Aging Time",
+			fmt.Sprintf("Failed to reset MAC aging time to default: %s", err),
+		)
+	}
+}
+
+// ImportState imports the MAC address aging time by fetching it from the device.
+func (r *macAgingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	device, importID := providerutil.SplitImportID(req.ID)
+	r.client = r.devices.Get(ctx, device, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer providerutil.SetDevice(ctx, device, &resp.State, &resp.Diagnostics)
+	req.ID = importID
+
+	tflog.Debug(ctx, "Importing MAC aging time", map[string]any{"id": req.ID})
+
+	agingTime, err := r.client.GetMACAgingTime(ctx)
+	if err != nil {
+		if providerutil.AddUnsupportedFeatureError(&resp.Diagnostics, err) {
+			return
+		}
+		resp.Diagnostics.AddError("Error Importing MAC Aging Time", fmt.Sprintf("Unable to read MAC aging time: %s", err))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &macAgingModel{AgingTime: types.Int64Value(int64(agingTime))})...)
+}
@@ -0,0 +1,42 @@
+package mac_aging_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	// Import the test helper package.
+	"github.com/brennoo/terraform-provider-hrui/internal/provider"
+)
+
+// TestAccMACAgingResource provides an acceptance test for the
+// hrui_mac_aging resource. No recorded firmware has the aging time
+// setting, so creating the resource reports an unsupported feature
+// without reading or posting the page.
+func TestAccMACAgingResource(t *testing.T) {
+	providerFactories := provider.TestAccProtoV6ProviderFactories(t, "mac_aging_resource_test")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			// Step 1: Setting the aging time fails before anything is posted
+			{
+				Config:      testAccMACAgingResourceConfig(),
+				ExpectError: regexp.MustCompile(`Unsupported Feature`),
+			},
+		},
+	})
+}
+
+// testAccMACAgingResourceConfig generates the HCL for the hrui_mac_aging
+// resource.
+func testAccMACAgingResourceConfig() string {
+	return `
+provider "hrui" {}
+
+resource "hrui_mac_aging" "test" {
+  aging_time = 60
+}
+`
+}
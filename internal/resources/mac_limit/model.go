@@ -9,4 +9,5 @@ type macLimitModel struct {
 	Port    types.String `tfsdk:"port"`
 	Enabled types.Bool   `tfsdk:"enabled"`
 	Limit   types.Int64  `tfsdk:"limit"`
+	Device  types.String `tfsdk:"device"`
 }
@@ -25,7 +25,8 @@ var (
 
 // macLimitResource is the implementation of the MAC Limit Terraform resource.
 type macLimitResource struct {
-	client *sdk.HRUIClient
+	client  *sdk.HRUIClient
+	devices *providerutil.Devices
 }
 
 // NewResource creates a new instance of the MAC Limit resource.
@@ -43,6 +44,7 @@ func (r *macLimitResource) Schema(ctx context.Context, req resource.SchemaReques
 	resp.Schema = schema.Schema{
 		Description: "Manages MAC address entry limits for specific ports.",
 		Attributes: map[string]schema.Attribute{
+			"device": providerutil.DeviceResourceAttribute(),
 			"port": schema.StringAttribute{
 				Required:    true,
 				Description: "The name of the port to configure (e.g., 'Port 1', 'Trunk2').",
@@ -64,7 +66,7 @@ func (r *macLimitResource) Schema(ctx context.Context, req resource.SchemaReques
 
 // Configure assigns the provider-configured client to the resource.
 func (r *macLimitResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	r.client = providerutil.ConfigureClient(req.ProviderData, &resp.Diagnostics)
+	r.devices = providerutil.ConfigureDevices(req.ProviderData, &resp.Diagnostics)
 }
 
 // retrievePortID resolves the port name to a numeric PortID using the SDK.
@@ -82,6 +84,12 @@ func (r *macLimitResource) retrievePortID(ctx context.Context, portName string,
 
 // Create sets the MAC limit for the specified port.
 func (r *macLimitResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	r.client = r.devices.Client(ctx, req.Plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer providerutil.KeepDevice(ctx, req.Plan, &resp.State, &resp.Diagnostics)
+
 	var plan macLimitModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
@@ -157,6 +165,12 @@ func (r *macLimitResource) Create(ctx context.Context, req resource.CreateReques
 
 // Read fetches the current MAC limit configuration for the resource and updates the state.
 func (r *macLimitResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	r.client = r.devices.Client(ctx, req.State, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer providerutil.KeepDevice(ctx, req.State, &resp.State, &resp.Diagnostics)
+
 	var state macLimitModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
@@ -204,6 +218,12 @@ func (r *macLimitResource) Read(ctx context.Context, req resource.ReadRequest, r
 
 // Update modifies the MAC limit for the specified port.
 func (r *macLimitResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	r.client = r.devices.Client(ctx, req.Plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer providerutil.KeepDevice(ctx, req.Plan, &resp.State, &resp.Diagnostics)
+
 	var plan macLimitModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
@@ -279,6 +299,11 @@ func (r *macLimitResource) Update(ctx context.Context, req resource.UpdateReques
 
 // Delete disables the MAC limit for the specified port.
 func (r *macLimitResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	r.client = r.devices.Client(ctx, req.State, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var state macLimitModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
@@ -305,6 +330,14 @@ func (r *macLimitResource) Delete(ctx context.Context, req resource.DeleteReques
 
 // ImportState imports an existing MAC Limit resource by port name.
 func (r *macLimitResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	device, importID := providerutil.SplitImportID(req.ID)
+	r.client = r.devices.Get(ctx, device, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer providerutil.SetDevice(ctx, device, &resp.State, &resp.Diagnostics)
+	req.ID = importID
+
 	tflog.Debug(ctx, "Importing MAC limit", map[string]any{"id": req.ID})
 
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("port"), req.ID)...)
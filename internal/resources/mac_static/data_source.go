@@ -15,7 +15,8 @@ var _ datasource.DataSource = &macStaticDataSource{}
 
 // macStaticDataSource retrieves static MAC addresses from the HRUI device.
 type macStaticDataSource struct {
-	client *sdk.HRUIClient
+	client  *sdk.HRUIClient
+	devices *providerutil.Devices
 }
 
 // NewDataSource initializes a new instance of the data source.
@@ -33,6 +34,7 @@ func (d *macStaticDataSource) Schema(ctx context.Context, req datasource.SchemaR
 	resp.Schema = schema.Schema{
 		Description: "Data source for querying static MAC addresses.",
 		Attributes: map[string]schema.Attribute{
+			"device": providerutil.DeviceDataSourceAttribute(),
 			"mac_address": schema.StringAttribute{
 				Description: "Filter results by a specific MAC address in the format xx:xx:xx:xx:xx:xx.",
 				Optional:    true,
@@ -71,11 +73,17 @@ func (d *macStaticDataSource) Schema(ctx context.Context, req datasource.SchemaR
 
 // Configure assigns the SDK client from provider configuration.
 func (d *macStaticDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
-	d.client = providerutil.ConfigureClient(req.ProviderData, &resp.Diagnostics)
+	d.devices = providerutil.ConfigureDevices(req.ProviderData, &resp.Diagnostics)
 }
 
 // Read queries static MAC addresses and returns the filtered results.
 func (d *macStaticDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	d.client = d.devices.Client(ctx, req.Config, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer providerutil.KeepDevice(ctx, req.Config, &resp.State, &resp.Diagnostics)
+
 	// Extract filters from the request
 	var filters macStaticDataSourceModel
 	diags := req.Config.Get(ctx, &filters)
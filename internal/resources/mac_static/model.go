@@ -7,6 +7,7 @@ type macStaticModel struct {
 	MACAddress types.String `tfsdk:"mac_address"`
 	VLANID     types.Int64  `tfsdk:"vlan_id"`
 	Port       types.String `tfsdk:"port"`
+	Device     types.String `tfsdk:"device"`
 }
 
 // macStaticDataSourceModel represents the filter inputs and computed outputs for the data source.
@@ -15,6 +16,7 @@ type macStaticDataSourceModel struct {
 	VLANID     types.Int64           `tfsdk:"vlan_id"`
 	Port       types.String          `tfsdk:"port"`
 	Entries    []macStaticEntryModel `tfsdk:"entries"`
+	Device     types.String          `tfsdk:"device"`
 }
 
 // macStaticEntryModel represents an individual static MAC entry in the output.
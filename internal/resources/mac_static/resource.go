@@ -2,6 +2,7 @@ package mac_static
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strconv"
 	"strings"
@@ -24,7 +25,8 @@ var (
 
 // macStaticResource manages static MAC entries on the switch.
 type macStaticResource struct {
-	client *sdk.HRUIClient
+	client  *sdk.HRUIClient
+	devices *providerutil.Devices
 }
 
 // NewResource initializes a new instance of the `macStaticResource`.
@@ -42,6 +44,7 @@ func (r *macStaticResource) Schema(_ context.Context, _ resource.SchemaRequest,
 	resp.Schema = schema.Schema{
 		Description: "Manages static MAC address entries.",
 		Attributes: map[string]schema.Attribute{
+			"device": providerutil.DeviceResourceAttribute(),
 			"mac_address": schema.StringAttribute{
 				Description: "The MAC address in the format xx:xx:xx:xx:xx:xx.",
 				Required:    true,
@@ -60,11 +63,17 @@ func (r *macStaticResource) Schema(_ context.Context, _ resource.SchemaRequest,
 
 // Configure assigns the SDK client from provider configuration.
 func (r *macStaticResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	r.client = providerutil.ConfigureClient(req.ProviderData, &resp.Diagnostics)
+	r.devices = providerutil.ConfigureDevices(req.ProviderData, &resp.Diagnostics)
 }
 
 // Create a new static MAC entry.
 func (r *macStaticResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	r.client = r.devices.Client(ctx, req.Plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer providerutil.KeepDevice(ctx, req.Plan, &resp.State, &resp.Diagnostics)
+
 	// Extract input configuration
 	var data macStaticModel
 	diags := req.Plan.Get(ctx, &data)
@@ -91,6 +100,12 @@ func (r *macStaticResource) Create(ctx context.Context, req resource.CreateReque
 
 // Read retrieves the static MAC entry from the device.
 func (r *macStaticResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	r.client = r.devices.Client(ctx, req.State, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer providerutil.KeepDevice(ctx, req.State, &resp.State, &resp.Diagnostics)
+
 	// Get current state
 	var state macStaticModel
 	diags := req.State.Get(ctx, &state)
@@ -101,39 +116,37 @@ func (r *macStaticResource) Read(ctx context.Context, req resource.ReadRequest,
 
 	tflog.Debug(ctx, "Reading static MAC entry", map[string]any{"mac_address": state.MACAddress.ValueString()})
 
-	// Use MAC address and VLAN ID directly from state
-	macAddress := state.MACAddress.ValueString()
-	vlanIDStr := fmt.Sprintf("%d", state.VLANID.ValueInt64())
-
-	// Fetch current MAC table from the SDK
-	macTable, err := r.client.GetStaticMACAddressTable(ctx)
+	// Fetch the entry by the MAC address and VLAN ID from state
+	entry, err := r.client.GetStaticMACEntry(ctx, state.MACAddress.ValueString(), int(state.VLANID.ValueInt64()))
+	if errors.Is(err, sdk.ErrNotFound) {
+		tflog.Warn(ctx, "Static MAC entry no longer exists on the device, removing from state", map[string]any{"mac_address": state.MACAddress.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError("Error Reading Static MAC Entry", err.Error())
 		return
 	}
 
-	// Look for the specific entry
-	for _, entry := range macTable {
-		if entry.MACAddress == macAddress && fmt.Sprintf("%d", entry.VLANID) == vlanIDStr {
-			state.MACAddress = types.StringValue(entry.MACAddress)
-			state.VLANID = types.Int64Value(int64(entry.VLANID))
-			state.Port = types.StringValue(entry.Port)
+	state.MACAddress = types.StringValue(entry.MACAddress)
+	state.VLANID = types.Int64Value(int64(entry.VLANID))
+	state.Port = types.StringValue(entry.Port)
 
-			// Update the state
-			diags = resp.State.Set(ctx, &state)
-			resp.Diagnostics.Append(diags...)
-
-			tflog.Debug(ctx, "Static MAC entry read", map[string]any{"mac_address": state.MACAddress.ValueString()})
-			return
-		}
-	}
+	// Update the state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
 
-	// If entry is not found, mark the resource as removed
-	resp.State.RemoveResource(ctx)
+	tflog.Debug(ctx, "Static MAC entry read", map[string]any{"mac_address": state.MACAddress.ValueString()})
 }
 
 // Update modifies an existing static MAC entry.
 func (r *macStaticResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	r.client = r.devices.Client(ctx, req.Plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer providerutil.KeepDevice(ctx, req.Plan, &resp.State, &resp.Diagnostics)
+
 	var state macStaticModel
 	// Get the existing state
 	diags := req.State.Get(ctx, &state)
@@ -185,6 +198,11 @@ func (r *macStaticResource) Update(ctx context.Context, req resource.UpdateReque
 
 // Delete removes a static MAC entry using the SDK.
 func (r *macStaticResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	r.client = r.devices.Client(ctx, req.State, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Extract state
 	var state macStaticModel
 	diags := req.State.Get(ctx, &state)
@@ -208,6 +226,14 @@ func (r *macStaticResource) Delete(ctx context.Context, req resource.DeleteReque
 
 // ImportState imports an existing Static MAC Entry resource by mac_address/vlan_id composite ID.
 func (r *macStaticResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	device, importID := providerutil.SplitImportID(req.ID)
+	r.client = r.devices.Get(ctx, device, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer providerutil.SetDevice(ctx, device, &resp.State, &resp.Diagnostics)
+	req.ID = importID
+
 	tflog.Debug(ctx, "Importing static MAC entry", map[string]any{"id": req.ID})
 
 	parts := strings.SplitN(req.ID, "/", 2)
@@ -15,7 +15,8 @@ var _ datasource.DataSource = &macTableDataSource{}
 
 // macTableDataSource defines the structure of the MAC table data source.
 type macTableDataSource struct {
-	client *sdk.HRUIClient
+	client  *sdk.HRUIClient
+	devices *providerutil.Devices
 }
 
 // NewDataSource creates a new instance of the MAC table data source.
@@ -33,6 +34,7 @@ func (d *macTableDataSource) Schema(_ context.Context, _ datasource.SchemaReques
 	resp.Schema = schema.Schema{
 		Description: "Data source for retrieving the static MAC address table.",
 		Attributes: map[string]schema.Attribute{
+			"device": providerutil.DeviceDataSourceAttribute(),
 			"mac_table": schema.ListNestedAttribute{
 				Description: "List of static MAC table entries.",
 				Computed:    true,
@@ -67,11 +69,17 @@ func (d *macTableDataSource) Schema(_ context.Context, _ datasource.SchemaReques
 
 // Configure associates the client to the data source.
 func (d *macTableDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
-	d.client = providerutil.ConfigureClient(req.ProviderData, &resp.Diagnostics)
+	d.devices = providerutil.ConfigureDevices(req.ProviderData, &resp.Diagnostics)
 }
 
 // Read fetches the MAC address table data from the switch.
 func (d *macTableDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	d.client = d.devices.Client(ctx, req.Config, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer providerutil.KeepDevice(ctx, req.Config, &resp.State, &resp.Diagnostics)
+
 	// Call the SDK to fetch the MAC address table
 	macTable, err := d.client.GetStaticMACAddressTable(ctx)
 	if err != nil {
@@ -14,4 +14,5 @@ type macTableModel struct {
 // macTableDataSourceModel represents the entire MAC table data source for Terraform's state.
 type macTableDataSourceModel struct {
 	MacTable []macTableModel `tfsdk:"mac_table"`
+	Device   types.String    `tfsdk:"device"`
 }
@@ -0,0 +1,12 @@
+package management_settings
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// managementSettingsModel represents the management interface settings.
+type managementSettingsModel struct {
+	VlanID     types.Int64  `tfsdk:"vlan_id"`
+	UplinkPort types.String `tfsdk:"uplink_port"`
+	Device     types.String `tfsdk:"device"`
+}
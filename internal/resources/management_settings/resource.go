@@ -0,0 +1,258 @@
+package management_settings
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/brennoo/terraform-provider-hrui/internal/providerutil"
+	"github.com/brennoo/terraform-provider-hrui/internal/sdk"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the required interfaces.
+var (
+	_ resource.Resource                = &managementSettingsResource{}
+	_ resource.ResourceWithConfigure   = &managementSettingsResource{}
+	_ resource.ResourceWithImportState = &managementSettingsResource{}
+	_ resource.ResourceWithModifyPlan  = &managementSettingsResource{}
+)
+
+// managementSettingsResource is the implementation of the management settings Terraform resource.
+type managementSettingsResource struct {
+	client  *sdk.HRUIClient
+	devices *providerutil.Devices
+}
+
+// NewResource creates a new instance of the management settings resource.
+func NewResource() resource.Resource {
+	return &managementSettingsResource{}
+}
+
+// Metadata sets the resource name for Terraform.
+func (r *managementSettingsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_management_settings"
+}
+
+// Schema defines the schema for the management settings resource.
+func (r *managementSettingsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Configures the management VLAN of the switch.",
+		MarkdownDescription: "Configures the VLAN the management interface of the switch listens on. " +
+			"The address of the management interface is configured with `hrui_ip_address_settings`. " +
+			"Requires firmware with a management VLAN setting. Firmware 1.9 and 1.9.1 are not known to have one, so on them the resource reports an unsupported feature error.",
+		Attributes: map[string]schema.Attribute{
+			"device": providerutil.DeviceResourceAttribute(),
+			"vlan_id": schema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: "The VLAN the management interface listens on (1-4094).",
+				Validators: []validator.Int64{
+					int64validator.Between(1, 4094),
+				},
+			},
+			"uplink_port": schema.StringAttribute{
+				Required: true,
+				MarkdownDescription: "The port Terraform reaches the switch through (e.g., 'Port 1', 'Trunk1'). " +
+					"The plan fails if `vlan_id` changes to a VLAN this port is not a member of, as the switch would become unreachable. The check is repeated before the change is applied.",
+			},
+		},
+	}
+}
+
+// Configure assigns the provider-configured client to the resource.
+func (r *managementSettingsResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.devices = providerutil.ConfigureDevices(req.ProviderData, &resp.Diagnostics)
+}
+
+// ModifyPlan fails the plan if the management VLAN moves to a VLAN the uplink port is not a member of.
+func (r *managementSettingsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	client := r.devices.PlanClient(ctx, req.Plan, req.State, &resp.Diagnostics)
+	if client == nil {
+		return
+	}
+
+	var plan managementSettingsModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.VlanID.IsUnknown() || plan.UplinkPort.IsUnknown() {
+		return
+	}
+	vlanID := int(plan.VlanID.ValueInt64())
+
+	current, err := client.GetManagementSettings(ctx)
+	if err != nil {
+		tflog.Debug(ctx, "Unable to check the management VLAN at plan time", map[string]any{"error": err.Error()})
+		return
+	}
+	if current.VLANID == vlanID {
+		return
+	}
+
+	vlans, err := client.ListVLANs(ctx)
+	if err != nil {
+		tflog.Debug(ctx, "Unable to check the management VLAN at plan time", map[string]any{"error": err.Error()})
+		return
+	}
+
+	if err := sdk.CheckManagementVLAN(vlans, vlanID, plan.UplinkPort.ValueString()); err != nil {
+		addUnreachableError(&resp.Diagnostics, current.VLANID, vlanID, err)
+	}
+}
+
+// addUnreachableError adds the error for a management VLAN the uplink port is not a member of.
+func addUnreachableError(diags *diag.Diagnostics, currentVLANID, vlanID int, err error) {
+	diags.AddAttributeError(
+		path.Root("vlan_id"),
+		"Management VLAN Not Reachable",
+		fmt.Sprintf("Moving the management interface from VLAN %d to VLAN %d would make the switch unreachable: %s. "+
+			"Add the uplink port to VLAN %d and apply that first.", currentVLANID, vlanID, err, vlanID),
+	)
+}
+
+// Create sets the management settings from the plan.
+func (r *managementSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	r.client = r.devices.Client(ctx, req.Plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer providerutil.KeepDevice(ctx, req.Plan, &resp.State, &resp.Diagnostics)
+
+	var plan managementSettingsModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating management settings", map[string]any{"vlan_id": plan.VlanID.ValueInt64()})
+
+	r.apply(ctx, &plan, "Error Creating Management Settings", &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read retrieves the management settings from the device and updates the state.
+func (r *managementSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	r.client = r.devices.Client(ctx, req.State, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer providerutil.KeepDevice(ctx, req.State, &resp.State, &resp.Diagnostics)
+
+	var state managementSettingsModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings, err := r.client.GetManagementSettings(ctx)
+	if err != nil {
+		if providerutil.AddUnsupportedFeatureError(&resp.Diagnostics, err) {
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading Management Settings",
+			fmt.Sprintf("Failed to read management settings: %s", err),
+		)
+		return
+	}
+
+	state.VlanID = types.Int64Value(int64(settings.VLANID))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update sets the changed management settings.
+func (r *managementSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	r.client = r.devices.Client(ctx, req.Plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer providerutil.KeepDevice(ctx, req.Plan, &resp.State, &resp.Diagnostics)
+
+	var plan managementSettingsModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Updating management settings", map[string]any{"vlan_id": plan.VlanID.ValueInt64()})
+
+	r.apply(ctx, &plan, "Error Updating Management Settings", &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// apply sets the management VLAN of plan. The plan-time check of the uplink port is skipped when
+// values are unknown or the device cannot be read, so it is repeated before the VLAN is moved.
+func (r *managementSettingsResource) apply(ctx context.Context, plan *managementSettingsModel, summary string, diags *diag.Diagnostics) {
+	vlanID := int(plan.VlanID.ValueInt64())
+
+	current, err := r.client.GetManagementSettings(ctx)
+	if err != nil {
+		if !providerutil.AddUnsupportedFeatureError(diags, err) {
+			diags.AddError(summary, fmt.Sprintf("Failed to read management settings: %s", err))
+		}
+		return
+	}
+
+	if current.VLANID != vlanID {
+		vlans, err := r.client.ListVLANs(ctx)
+		if err != nil {
+			diags.AddError(summary, fmt.Sprintf("Failed to read VLANs to check the uplink port: %s", err))
+			return
+		}
+		if err := sdk.CheckManagementVLAN(vlans, vlanID, plan.UplinkPort.ValueString()); err != nil {
+			addUnreachableError(diags, current.VLANID, vlanID, err)
+			return
+		}
+	}
+
+	if err := r.client.SetManagementSettings(ctx, &sdk.ManagementSettings{VLANID: vlanID}); err != nil {
+		if !providerutil.AddUnsupportedFeatureError(diags, err) {
+			diags.AddError(summary, fmt.Sprintf("Failed to set management settings: %s", err))
+		}
+	}
+}
+
+// Delete removes the resource from the state. Moving the management interface back to VLAN 1
+// could make the switch unreachable, so the management VLAN is left as it is.
+func (r *managementSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Deleting management settings; leaving the device management VLAN unchanged")
+}
+
+// ImportState imports the current management settings of the device. The uplink port is not
+// known to the switch and is set from the configuration on the next apply.
+func (r *managementSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	device, importID := providerutil.SplitImportID(req.ID)
+	r.client = r.devices.Get(ctx, device, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer providerutil.SetDevice(ctx, device, &resp.State, &resp.Diagnostics)
+	req.ID = importID
+
+	tflog.Debug(ctx, "Importing management settings", map[string]any{"id": req.ID})
+
+	settings, err := r.client.GetManagementSettings(ctx)
+	if err != nil {
+		if providerutil.AddUnsupportedFeatureError(&resp.Diagnostics, err) {
+			return
+		}
+		resp.Diagnostics.AddError("Error Importing Management Settings", fmt.Sprintf("Unable to import management settings: %s", err))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &managementSettingsModel{
+		VlanID:     types.Int64Value(int64(settings.VLANID)),
+		UplinkPort: types.StringNull(),
+	})...)
+}
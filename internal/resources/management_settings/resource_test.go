@@ -0,0 +1,43 @@
+package management_settings_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	// Import the test helper package.
+	"github.com/brennoo/terraform-provider-hrui/internal/provider"
+)
+
+// TestAccManagementSettingsResource provides an acceptance test for the
+// hrui_management_settings resource. No recorded firmware has the
+// management VLAN setting, so creating the resource reports an unsupported
+// feature without reading or posting the page.
+func TestAccManagementSettingsResource(t *testing.T) {
+	providerFactories := provider.TestAccProtoV6ProviderFactories(t, "management_settings_resource_test")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			// Step 1: Moving the management VLAN fails before anything is posted
+			{
+				Config:      testAccManagementSettingsResourceConfig(),
+				ExpectError: regexp.MustCompile(`Unsupported Feature`),
+			},
+		},
+	})
+}
+
+// testAccManagementSettingsResourceConfig generates the HCL for the
+// hrui_management_settings resource.
+func testAccManagementSettingsResourceConfig() string {
+	return `
+provider "hrui" {}
+
+resource "hrui_management_settings" "test" {
+  vlan_id     = 1
+  uplink_port = "Port 1"
+}
+`
+}
@@ -7,4 +7,5 @@ import (
 type portIsolationModel struct {
 	Port          types.String `tfsdk:"port"`
 	IsolationList types.List   `tfsdk:"isolation_list"`
+	Device        types.String `tfsdk:"device"`
 }
@@ -2,6 +2,7 @@ package port_isolation
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/brennoo/terraform-provider-hrui/internal/providerutil"
@@ -25,7 +26,8 @@ var (
 
 // portIsolationResource defines the resource implementation.
 type portIsolationResource struct {
-	client *sdk.HRUIClient
+	client  *sdk.HRUIClient
+	devices *providerutil.Devices
 }
 
 // NewResource initializes and returns a new resource instance.
@@ -43,6 +45,7 @@ func (r *portIsolationResource) Schema(_ context.Context, _ resource.SchemaReque
 	resp.Schema = schema.Schema{
 		Description: "Configures port isolation settings.",
 		Attributes: map[string]schema.Attribute{
+			"device": providerutil.DeviceResourceAttribute(),
 			"port": schema.StringAttribute{
 				Required:    true,
 				Description: "The port name for which isolation will be configured. Acts as an implicit identifier.",
@@ -61,11 +64,17 @@ func (r *portIsolationResource) Schema(_ context.Context, _ resource.SchemaReque
 
 // Configure assigns the provider-configured client to the resource.
 func (r *portIsolationResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	r.client = providerutil.ConfigureClient(req.ProviderData, &resp.Diagnostics)
+	r.devices = providerutil.ConfigureDevices(req.ProviderData, &resp.Diagnostics)
 }
 
 // Read fetches the current port isolation and updates the state.
 func (r *portIsolationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	r.client = r.devices.Client(ctx, req.State, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer providerutil.KeepDevice(ctx, req.State, &resp.State, &resp.Diagnostics)
+
 	// Read the state into the model
 	var state portIsolationModel
 	diags := req.State.Get(ctx, &state)
@@ -76,8 +85,13 @@ func (r *portIsolationResource) Read(ctx context.Context, req resource.ReadReque
 
 	tflog.Debug(ctx, "Reading port isolation", map[string]any{"port": state.Port.ValueString()})
 
-	// Fetch port isolation configuration from the SDK
-	portIsolations, err := r.client.GetPortIsolation(ctx)
+	// Fetch the isolation configuration for the current port from the SDK
+	isolation, err := r.client.GetPortIsolationByPort(ctx, state.Port.ValueString())
+	if errors.Is(err, sdk.ErrNotFound) {
+		tflog.Warn(ctx, "Port no longer exists on the device, removing from state", map[string]any{"port": state.Port.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading Port Isolation",
@@ -86,19 +100,8 @@ func (r *portIsolationResource) Read(ctx context.Context, req resource.ReadReque
 		return
 	}
 
-	// Find the isolation configuration for the current port
-	port := state.Port.ValueString()
-	var isolationList []string
-	for _, isolation := range portIsolations {
-		if isolation.Port == port {
-			// Use isolation list from the backend as-is
-			isolationList = isolation.IsolationList
-			break
-		}
-	}
-
-	// Update the Terraform state
-	state.IsolationList = convertToTerraformList(isolationList)
+	// Update the Terraform state, using the isolation list from the backend as-is
+	state.IsolationList = convertToTerraformList(isolation.IsolationList)
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 
@@ -107,6 +110,12 @@ func (r *portIsolationResource) Read(ctx context.Context, req resource.ReadReque
 
 // Create sets up the port isolation using the given plan values.
 func (r *portIsolationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	r.client = r.devices.Client(ctx, req.Plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer providerutil.KeepDevice(ctx, req.Plan, &resp.State, &resp.Diagnostics)
+
 	// Read the plan into the model
 	var plan portIsolationModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -139,6 +148,12 @@ func (r *portIsolationResource) Create(ctx context.Context, req resource.CreateR
 
 // Update modifies the port isolation.
 func (r *portIsolationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	r.client = r.devices.Client(ctx, req.Plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer providerutil.KeepDevice(ctx, req.Plan, &resp.State, &resp.Diagnostics)
+
 	// Read the plan into the model
 	var plan portIsolationModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -171,6 +186,11 @@ func (r *portIsolationResource) Update(ctx context.Context, req resource.UpdateR
 
 // Delete removes the port isolation.
 func (r *portIsolationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	r.client = r.devices.Client(ctx, req.State, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Read the state into the model
 	var state portIsolationModel
 	diags := req.State.Get(ctx, &state)
@@ -195,6 +215,14 @@ func (r *portIsolationResource) Delete(ctx context.Context, req resource.DeleteR
 
 // ImportState imports an existing Port Isolation resource by port name.
 func (r *portIsolationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	device, importID := providerutil.SplitImportID(req.ID)
+	r.client = r.devices.Get(ctx, device, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer providerutil.SetDevice(ctx, device, &resp.State, &resp.Diagnostics)
+	req.ID = importID
+
 	tflog.Debug(ctx, "Importing port isolation", map[string]any{"id": req.ID})
 
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("port"), req.ID)...)
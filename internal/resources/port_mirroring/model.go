@@ -8,4 +8,5 @@ type portMirroringModel struct {
 	MirrorDirection types.String `tfsdk:"mirror_direction"`
 	MirroringPort   types.String `tfsdk:"mirroring_port"`
 	MirroredPort    types.String `tfsdk:"mirrored_port"`
+	Device          types.String `tfsdk:"device"`
 }
@@ -23,7 +23,8 @@ var (
 
 // portMirroringResource defines the resource implementation.
 type portMirroringResource struct {
-	client *sdk.HRUIClient
+	client  *sdk.HRUIClient
+	devices *providerutil.Devices
 }
 
 // NewResource initializes and returns a new resource instance.
@@ -41,6 +42,7 @@ func (r *portMirroringResource) Schema(_ context.Context, _ resource.SchemaReque
 	resp.Schema = schema.Schema{
 		Description: "Configures port mirroring settings.",
 		Attributes: map[string]schema.Attribute{
+			"device": providerutil.DeviceResourceAttribute(),
 			"mirror_direction": schema.StringAttribute{
 				Required:    true,
 				Description: "The mirroring direction: 'Rx', 'Tx', or 'BOTH'.",
@@ -62,11 +64,17 @@ func (r *portMirroringResource) Schema(_ context.Context, _ resource.SchemaReque
 
 // Configure assigns the provider-configured client to the resource for making API calls.
 func (r *portMirroringResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	r.client = providerutil.ConfigureClient(req.ProviderData, &resp.Diagnostics)
+	r.devices = providerutil.ConfigureDevices(req.ProviderData, &resp.Diagnostics)
 }
 
 // Create sets up the port mirroring configuration using the given plan values.
 func (r *portMirroringResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	r.client = r.devices.Client(ctx, req.Plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer providerutil.KeepDevice(ctx, req.Plan, &resp.State, &resp.Diagnostics)
+
 	tflog.Debug(ctx, "Creating port mirroring settings")
 
 	var plan portMirroringModel
@@ -103,6 +111,12 @@ func (r *portMirroringResource) Create(ctx context.Context, req resource.CreateR
 
 // Read fetches and updates the resource state based on the actual configuration.
 func (r *portMirroringResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	r.client = r.devices.Client(ctx, req.State, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer providerutil.KeepDevice(ctx, req.State, &resp.State, &resp.Diagnostics)
+
 	tflog.Debug(ctx, "Reading port mirroring settings")
 
 	var state portMirroringModel
@@ -143,6 +157,12 @@ func (r *portMirroringResource) Read(ctx context.Context, req resource.ReadReque
 
 // Update modifies the port mirroring configuration based on the plan.
 func (r *portMirroringResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	r.client = r.devices.Client(ctx, req.Plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer providerutil.KeepDevice(ctx, req.Plan, &resp.State, &resp.Diagnostics)
+
 	tflog.Debug(ctx, "Updating port mirroring settings")
 
 	var plan portMirroringModel
@@ -179,6 +199,11 @@ func (r *portMirroringResource) Update(ctx context.Context, req resource.UpdateR
 
 // Delete removes the port mirroring configuration from the system.
 func (r *portMirroringResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	r.client = r.devices.Client(ctx, req.State, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	tflog.Debug(ctx, "Deleting port mirroring settings")
 
 	// Call the SDK to delete the port mirroring configuration
@@ -192,6 +217,14 @@ func (r *portMirroringResource) Delete(ctx context.Context, req resource.DeleteR
 
 // ImportState imports an existing Port Mirroring resource by fetching the current state.
 func (r *portMirroringResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	device, importID := providerutil.SplitImportID(req.ID)
+	r.client = r.devices.Get(ctx, device, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer providerutil.SetDevice(ctx, device, &resp.State, &resp.Diagnostics)
+	req.ID = importID
+
 	tflog.Debug(ctx, "Importing port mirroring settings", map[string]any{"id": req.ID})
 
 	portMirror, err := r.client.GetPortMirror(ctx)
@@ -19,7 +19,8 @@ var (
 
 // portSettingDataSource is the data source implementation.
 type portSettingDataSource struct {
-	client *sdk.HRUIClient
+	client  *sdk.HRUIClient
+	devices *providerutil.Devices
 }
 
 // NewDataSource is a helper function to instantiate the data source.
@@ -31,6 +32,7 @@ func (d *portSettingDataSource) Schema(ctx context.Context, req datasource.Schem
 	resp.Schema = schema.Schema{
 		Description: "Data source for retrieving port settings.",
 		Attributes: map[string]schema.Attribute{
+			"device": providerutil.DeviceDataSourceAttribute(),
 			"port": schema.StringAttribute{
 				Required:            true,
 				MarkdownDescription: "The port name or ID (e.g., 'Port 1', 'Trunk1').",
@@ -73,7 +75,7 @@ func (d *portSettingDataSource) Schema(ctx context.Context, req datasource.Schem
 
 // Configure assigns the provider-configured client to the data source.
 func (d *portSettingDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
-	d.client = providerutil.ConfigureClient(req.ProviderData, &resp.Diagnostics)
+	d.devices = providerutil.ConfigureDevices(req.ProviderData, &resp.Diagnostics)
 }
 
 // Metadata defines the schema type name.
@@ -83,6 +85,12 @@ func (d *portSettingDataSource) Metadata(ctx context.Context, req datasource.Met
 
 // Read reads the current port settings from the HRUI system.
 func (d *portSettingDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	d.client = d.devices.Client(ctx, req.Config, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer providerutil.KeepDevice(ctx, req.Config, &resp.State, &resp.Diagnostics)
+
 	var data portSettingModel
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
@@ -10,6 +10,7 @@ type portSettingModel struct {
 	Enabled     types.Bool              `tfsdk:"enabled"`
 	Speed       *portSettingSpeed       `tfsdk:"speed"`
 	FlowControl *portSettingFlowControl `tfsdk:"flow_control"`
+	Device      types.String            `tfsdk:"device"`
 }
 
 type portSettingSpeed struct {
@@ -2,6 +2,7 @@ package port_settings
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/brennoo/terraform-provider-hrui/internal/providerutil"
@@ -24,7 +25,8 @@ var (
 
 // portSettingResource is the resource implementation.
 type portSettingResource struct {
-	client *sdk.HRUIClient
+	client  *sdk.HRUIClient
+	devices *providerutil.Devices
 }
 
 // NewResource is a helper function to simplify the provider implementation.
@@ -36,6 +38,7 @@ func (r *portSettingResource) Schema(ctx context.Context, req resource.SchemaReq
 	resp.Schema = schema.Schema{
 		Description: "Manages port settings.",
 		Attributes: map[string]schema.Attribute{
+			"device": providerutil.DeviceResourceAttribute(),
 			"port": schema.StringAttribute{
 				Required:    true,
 				Description: "The port name or ID (e.g., 'Port 1', 'Trunk1').",
@@ -84,7 +87,7 @@ func (r *portSettingResource) Schema(ctx context.Context, req resource.SchemaReq
 
 // Configure assigns the provider-configured client to the resource.
 func (r *portSettingResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	r.client = providerutil.ConfigureClient(req.ProviderData, &resp.Diagnostics)
+	r.devices = providerutil.ConfigureDevices(req.ProviderData, &resp.Diagnostics)
 }
 
 // Metadata sets the resource name.
@@ -94,6 +97,12 @@ func (r *portSettingResource) Metadata(ctx context.Context, req resource.Metadat
 
 // Create creates the port settings in the HRUI system.
 func (r *portSettingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	r.client = r.devices.Client(ctx, req.Plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer providerutil.KeepDevice(ctx, req.Plan, &resp.State, &resp.Diagnostics)
+
 	var plan portSettingModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
@@ -130,6 +139,9 @@ func (r *portSettingResource) Create(ctx context.Context, req resource.CreateReq
 	// Call API to configure the port
 	_, err := r.client.ConfigurePort(ctx, port)
 	if err != nil {
+		if providerutil.AddUnsupportedFeatureError(&resp.Diagnostics, err) {
+			return
+		}
 		resp.Diagnostics.AddError("Error Creating Port Settings", fmt.Sprintf("Failed to create HRUI port settings: %s", err))
 		return
 	}
@@ -165,6 +177,12 @@ func (r *portSettingResource) Create(ctx context.Context, req resource.CreateReq
 
 // Read fetches the current port settings and updates state.
 func (r *portSettingResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	r.client = r.devices.Client(ctx, req.State, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer providerutil.KeepDevice(ctx, req.State, &resp.State, &resp.Diagnostics)
+
 	var state portSettingModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
@@ -175,6 +193,11 @@ func (r *portSettingResource) Read(ctx context.Context, req resource.ReadRequest
 
 	// Fetch the current data for the port from the switch
 	port, err := r.client.GetPort(ctx, state.Port.ValueString())
+	if errors.Is(err, sdk.ErrNotFound) {
+		tflog.Warn(ctx, "Port no longer exists on the device, removing from state", map[string]any{"port": state.Port.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError("Error Reading Port Settings", fmt.Sprintf("Failed to read HRUI port settings: %s", err))
 		return
@@ -206,6 +229,12 @@ func (r *portSettingResource) Read(ctx context.Context, req resource.ReadRequest
 
 // Update applies changes to the port settings.
 func (r *portSettingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	r.client = r.devices.Client(ctx, req.Plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer providerutil.KeepDevice(ctx, req.Plan, &resp.State, &resp.Diagnostics)
+
 	var plan portSettingModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
@@ -241,6 +270,9 @@ func (r *portSettingResource) Update(ctx context.Context, req resource.UpdateReq
 
 	_, err := r.client.ConfigurePort(ctx, port)
 	if err != nil {
+		if providerutil.AddUnsupportedFeatureError(&resp.Diagnostics, err) {
+			return
+		}
 		resp.Diagnostics.AddError("Error Updating Port Settings", fmt.Sprintf("Unable to update HRUI port settings, got error: %s", err))
 		return
 	}
@@ -275,6 +307,11 @@ func (r *portSettingResource) Update(ctx context.Context, req resource.UpdateReq
 }
 
 func (r *portSettingResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	r.client = r.devices.Client(ctx, req.State, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var state portSettingModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
@@ -307,6 +344,14 @@ func (r *portSettingResource) Delete(ctx context.Context, req resource.DeleteReq
 
 // ImportState maps the imported ID to the `port` field.
 func (r *portSettingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	device, importID := providerutil.SplitImportID(req.ID)
+	r.client = r.devices.Get(ctx, device, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer providerutil.SetDevice(ctx, device, &resp.State, &resp.Diagnostics)
+	req.ID = importID
+
 	tflog.Debug(ctx, "Importing port settings", map[string]any{"id": req.ID})
 
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("port"), req.ID)...) // Use `port` as the unique ID
@@ -12,7 +12,8 @@ import (
 
 // portStatisticsDataSource defines the Port Statistics data source.
 type portStatisticsDataSource struct {
-	client *sdk.HRUIClient
+	client  *sdk.HRUIClient
+	devices *providerutil.Devices
 }
 
 // Ensure the data source implements necessary interfaces.
@@ -36,6 +37,7 @@ func (d *portStatisticsDataSource) Schema(_ context.Context, _ datasource.Schema
 	resp.Schema = schema.Schema{
 		Description: "Data source for retrieving port statistics.",
 		Attributes: map[string]schema.Attribute{
+			"device": providerutil.DeviceDataSourceAttribute(),
 			"port_statistics": schema.ListNestedAttribute{
 				Description: "List of port statistics retrieved from the switch.",
 				Computed:    true,
@@ -90,11 +92,17 @@ func (d *portStatisticsDataSource) Schema(_ context.Context, _ datasource.Schema
 
 // Configure associates the client to the data source.
 func (d *portStatisticsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
-	d.client = providerutil.ConfigureClient(req.ProviderData, &resp.Diagnostics)
+	d.devices = providerutil.ConfigureDevices(req.ProviderData, &resp.Diagnostics)
 }
 
 // Read fetches the port statistics from the switch.
 func (d *portStatisticsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	d.client = d.devices.Client(ctx, req.Config, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer providerutil.KeepDevice(ctx, req.Config, &resp.State, &resp.Diagnostics)
+
 	// Ensure the client is not nil
 	if d.client == nil {
 		resp.Diagnostics.AddError(
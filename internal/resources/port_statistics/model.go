@@ -20,4 +20,5 @@ type packetStatistics struct {
 // portStatisticsDataSourceModel represents the data source schema.
 type portStatisticsDataSourceModel struct {
 	PortStatistics []portStatisticsModel `tfsdk:"port_statistics"`
+	Device         types.String          `tfsdk:"device"`
 }
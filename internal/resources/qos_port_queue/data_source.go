@@ -19,7 +19,8 @@ var (
 
 // qosPortQueueDataSource implements the QoS Port Queue data source.
 type qosPortQueueDataSource struct {
-	client *sdk.HRUIClient
+	client  *sdk.HRUIClient
+	devices *providerutil.Devices
 }
 
 // NewDataSource initializes a new QoS Port Queue data source.
@@ -37,6 +38,7 @@ func (d *qosPortQueueDataSource) Schema(_ context.Context, _ datasource.SchemaRe
 	resp.Schema = schema.Schema{
 		Description: "Data source for retrieving QoS port queue settings.",
 		Attributes: map[string]schema.Attribute{
+			"device": providerutil.DeviceDataSourceAttribute(),
 			"port": schema.StringAttribute{
 				Required:            true,
 				MarkdownDescription: "The port name for which the QoS queue is being fetched.",
@@ -51,11 +53,17 @@ func (d *qosPortQueueDataSource) Schema(_ context.Context, _ datasource.SchemaRe
 
 // Configure adds the provider configured client to the data source.
 func (d *qosPortQueueDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
-	d.client = providerutil.ConfigureClient(req.ProviderData, &resp.Diagnostics)
+	d.devices = providerutil.ConfigureDevices(req.ProviderData, &resp.Diagnostics)
 }
 
 // Read fetches the latest data for the QoS Port Queue data source.
 func (d *qosPortQueueDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	d.client = d.devices.Client(ctx, req.Config, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer providerutil.KeepDevice(ctx, req.Config, &resp.State, &resp.Diagnostics)
+
 	var state qosPortQueueModel
 
 	// Get config.
@@ -2,6 +2,7 @@ package qos_port_queue
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/brennoo/terraform-provider-hrui/internal/providerutil"
@@ -23,7 +24,8 @@ var (
 
 // qosPortQueueResource defines the resource implementation.
 type qosPortQueueResource struct {
-	client *sdk.HRUIClient
+	client  *sdk.HRUIClient
+	devices *providerutil.Devices
 }
 
 // NewResource creates a new resource instance.
@@ -41,6 +43,7 @@ func (r *qosPortQueueResource) Schema(ctx context.Context, req resource.SchemaRe
 	resp.Schema = schema.Schema{
 		Description: "Configures QoS port queue settings.",
 		Attributes: map[string]schema.Attribute{
+			"device": providerutil.DeviceResourceAttribute(),
 			"port": schema.StringAttribute{
 				Required:            true,
 				MarkdownDescription: "The port name for which the QoS queue is being configured (e.g., 'Port 1', 'Trunk2').",
@@ -58,11 +61,17 @@ func (r *qosPortQueueResource) Schema(ctx context.Context, req resource.SchemaRe
 
 // Configure sets up the client for the resource.
 func (r *qosPortQueueResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	r.client = providerutil.ConfigureClient(req.ProviderData, &resp.Diagnostics)
+	r.devices = providerutil.ConfigureDevices(req.ProviderData, &resp.Diagnostics)
 }
 
 // Create creates a new QoS Port Queue resource.
 func (r *qosPortQueueResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	r.client = r.devices.Client(ctx, req.Plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer providerutil.KeepDevice(ctx, req.Plan, &resp.State, &resp.Diagnostics)
+
 	var plan qosPortQueueModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -103,6 +112,12 @@ func (r *qosPortQueueResource) Create(ctx context.Context, req resource.CreateRe
 
 // Update updates the QoS Port Queue resource.
 func (r *qosPortQueueResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	r.client = r.devices.Client(ctx, req.Plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer providerutil.KeepDevice(ctx, req.Plan, &resp.State, &resp.Diagnostics)
+
 	var plan qosPortQueueModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -144,6 +159,12 @@ func (r *qosPortQueueResource) Update(ctx context.Context, req resource.UpdateRe
 
 // Read refreshes the state of the QoS Port Queue resource.
 func (r *qosPortQueueResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	r.client = r.devices.Client(ctx, req.State, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer providerutil.KeepDevice(ctx, req.State, &resp.State, &resp.Diagnostics)
+
 	var state qosPortQueueModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -155,6 +176,11 @@ func (r *qosPortQueueResource) Read(ctx context.Context, req resource.ReadReques
 
 	// Map the port name to its numeric ID.
 	portID, err := r.client.GetPortByName(ctx, state.Port.ValueString())
+	if errors.Is(err, sdk.ErrNotFound) {
+		tflog.Warn(ctx, "Port no longer exists on the device, removing from state", map[string]any{"port": state.Port.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError("Error Reading QoS Port Queue", fmt.Sprintf("Could not resolve port name '%s' to ID: %s", state.Port.ValueString(), err))
 		return
@@ -162,6 +188,11 @@ func (r *qosPortQueueResource) Read(ctx context.Context, req resource.ReadReques
 
 	// Query the current QoS queue for the resolved port ID.
 	portQueue, err := r.client.GetQoSPortQueue(ctx, portID)
+	if errors.Is(err, sdk.ErrNotFound) {
+		tflog.Warn(ctx, "QoS port queue no longer exists on the device, removing from state", map[string]any{"port": state.Port.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError("Error Reading QoS Port Queue", fmt.Sprintf("Unable to fetch QoS Port Queue for port '%s': %s", state.Port.ValueString(), err))
 		return
@@ -176,6 +207,11 @@ func (r *qosPortQueueResource) Read(ctx context.Context, req resource.ReadReques
 
 // Delete resets the QoS Port Queue resource to its default state.
 func (r *qosPortQueueResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	r.client = r.devices.Client(ctx, req.State, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var state qosPortQueueModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -205,6 +241,14 @@ func (r *qosPortQueueResource) Delete(ctx context.Context, req resource.DeleteRe
 
 // ImportState imports the resource state.
 func (r *qosPortQueueResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	device, importID := providerutil.SplitImportID(req.ID)
+	r.client = r.devices.Get(ctx, device, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer providerutil.SetDevice(ctx, device, &resp.State, &resp.Diagnostics)
+	req.ID = importID
+
 	tflog.Debug(ctx, "Importing QoS port queue", map[string]any{"id": req.ID})
 
 	port := req.ID
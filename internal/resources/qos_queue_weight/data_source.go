@@ -12,7 +12,8 @@ import (
 
 // qosQueueWeightDataSource defines the data source structure for a single queue.
 type qosQueueWeightDataSource struct {
-	client *sdk.HRUIClient
+	client  *sdk.HRUIClient
+	devices *providerutil.Devices
 }
 
 // NewDataSource creates a new instance of the data source for a single queue.
@@ -30,6 +31,7 @@ func (d *qosQueueWeightDataSource) Schema(_ context.Context, _ datasource.Schema
 	resp.Schema = schema.Schema{
 		Description: "Data source to fetch a specific QoS queue by its ID.",
 		Attributes: map[string]schema.Attribute{
+			"device": providerutil.DeviceDataSourceAttribute(),
 			"queue_id": schema.Int64Attribute{
 				Description: "The ID of the queue.",
 				Required:    true,
@@ -44,11 +46,17 @@ func (d *qosQueueWeightDataSource) Schema(_ context.Context, _ datasource.Schema
 
 // Configure associates the client to the data source.
 func (d *qosQueueWeightDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
-	d.client = providerutil.ConfigureClient(req.ProviderData, &resp.Diagnostics)
+	d.devices = providerutil.ConfigureDevices(req.ProviderData, &resp.Diagnostics)
 }
 
 // Read retrieves the weight of a specific queue based on the queue_id.
 func (d *qosQueueWeightDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	d.client = d.devices.Client(ctx, req.Config, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer providerutil.KeepDevice(ctx, req.Config, &resp.State, &resp.Diagnostics)
+
 	// Retrieve queue_id from current configuration
 	var state qosQueueWeightModel
 	diags := req.Config.Get(ctx, &state)
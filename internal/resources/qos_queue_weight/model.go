@@ -8,4 +8,5 @@ import (
 type qosQueueWeightModel struct {
 	QueueID types.Int64  `tfsdk:"queue_id"`
 	Weight  types.String `tfsdk:"weight"`
+	Device  types.String `tfsdk:"device"`
 }
@@ -26,7 +26,8 @@ var (
 
 // qosQueueWeightResource defines the resource implementation for queue weights.
 type qosQueueWeightResource struct {
-	client *sdk.HRUIClient
+	client  *sdk.HRUIClient
+	devices *providerutil.Devices
 }
 
 // NewResource creates a new resource instance.
@@ -44,6 +45,7 @@ func (r *qosQueueWeightResource) Schema(ctx context.Context, req resource.Schema
 	resp.Schema = schema.Schema{
 		Description: "Configures QoS queue weight settings.",
 		Attributes: map[string]schema.Attribute{
+			"device": providerutil.DeviceResourceAttribute(),
 			"queue_id": schema.Int64Attribute{
 				Required:            true,
 				MarkdownDescription: "The queue ID for which the weight is being configured.",
@@ -64,11 +66,17 @@ func (r *qosQueueWeightResource) Schema(ctx context.Context, req resource.Schema
 
 // Configure stores the provider's configured SDK client.
 func (r *qosQueueWeightResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	r.client = providerutil.ConfigureClient(req.ProviderData, &resp.Diagnostics)
+	r.devices = providerutil.ConfigureDevices(req.ProviderData, &resp.Diagnostics)
 }
 
 // Create creates the resource by posting necessary data to apply a queue weight.
 func (r *qosQueueWeightResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	r.client = r.devices.Client(ctx, req.Plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer providerutil.KeepDevice(ctx, req.Plan, &resp.State, &resp.Diagnostics)
+
 	var plan qosQueueWeightModel
 
 	// Read plan configuration values
@@ -112,6 +120,12 @@ func (r *qosQueueWeightResource) Create(ctx context.Context, req resource.Create
 
 // Read reads the current state of a queue weight.
 func (r *qosQueueWeightResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	r.client = r.devices.Client(ctx, req.State, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer providerutil.KeepDevice(ctx, req.State, &resp.State, &resp.Diagnostics)
+
 	var state qosQueueWeightModel
 
 	// Fetch current state
@@ -151,6 +165,12 @@ func (r *qosQueueWeightResource) Read(ctx context.Context, req resource.ReadRequ
 
 // Update updates the queue weight.
 func (r *qosQueueWeightResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	r.client = r.devices.Client(ctx, req.Plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer providerutil.KeepDevice(ctx, req.Plan, &resp.State, &resp.Diagnostics)
+
 	var plan qosQueueWeightModel
 
 	// Get the updated configuration from the Plan
@@ -192,6 +212,11 @@ func (r *qosQueueWeightResource) Update(ctx context.Context, req resource.Update
 
 // Delete resets a queue to default weight.
 func (r *qosQueueWeightResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	r.client = r.devices.Client(ctx, req.State, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var state qosQueueWeightModel
 
 	// Read current state
@@ -218,6 +243,14 @@ func (r *qosQueueWeightResource) Delete(ctx context.Context, req resource.Delete
 
 // ImportState allows importing existing configurations via "terraform import".
 func (r *qosQueueWeightResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	device, importID := providerutil.SplitImportID(req.ID)
+	r.client = r.devices.Get(ctx, device, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer providerutil.SetDevice(ctx, device, &resp.State, &resp.Diagnostics)
+	req.ID = importID
+
 	tflog.Debug(ctx, "Importing QoS queue weight", map[string]any{"id": req.ID})
 
 	queueID, err := strconv.ParseInt(req.ID, 10, 64)
@@ -0,0 +1,75 @@
+package reboot
+
+import (
+	"context"
+
+	"github.com/brennoo/terraform-provider-hrui/internal/providerutil"
+	"github.com/brennoo/terraform-provider-hrui/internal/sdk"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+)
+
+// Ensure the implementation satisfies the required interfaces.
+var (
+	_ action.Action              = &rebootAction{}
+	_ action.ActionWithConfigure = &rebootAction{}
+)
+
+// rebootAction reboots the switch when it is invoked.
+type rebootAction struct {
+	client  *sdk.HRUIClient
+	devices *providerutil.Devices
+}
+
+// NewAction creates a new instance of the reboot action.
+func NewAction() action.Action {
+	return &rebootAction{}
+}
+
+// Metadata sets the action name for Terraform.
+func (a *rebootAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_reboot"
+}
+
+// Schema defines the schema for the reboot action.
+func (a *rebootAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Reboots the switch.",
+		MarkdownDescription: "Reboots the switch and waits until it accepts logins again. Unsaved changes are saved first. " +
+			"Requires Terraform 1.14 or later; use the `hrui_reboot` resource with older versions.",
+		Attributes: map[string]schema.Attribute{
+			"device": providerutil.DeviceActionAttribute(),
+			"factory_reset": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: factoryResetDescription,
+			},
+			"ready_timeout": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: readyTimeoutDescription,
+			},
+		},
+	}
+}
+
+// Configure assigns the provider-configured client to the action.
+func (a *rebootAction) Configure(_ context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	a.devices = providerutil.ConfigureDevices(req.ProviderData, &resp.Diagnostics)
+}
+
+// Invoke reboots the switch.
+func (a *rebootAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	a.client = a.devices.Client(ctx, req.Config, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var config rebootActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	restartSwitch(ctx, a.client, config.FactoryReset, config.ReadyTimeout, func(message string) {
+		resp.SendProgress(action.InvokeProgressEvent{Message: message})
+	}, &resp.Diagnostics)
+}
@@ -0,0 +1,21 @@
+package reboot
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// rebootModel represents the state model for the reboot Terraform resource.
+type rebootModel struct {
+	ID           types.String `tfsdk:"id"`
+	Triggers     types.Map    `tfsdk:"triggers"`
+	FactoryReset types.Bool   `tfsdk:"factory_reset"`
+	ReadyTimeout types.String `tfsdk:"ready_timeout"`
+	Device       types.String `tfsdk:"device"`
+}
+
+// rebootActionModel represents the configuration of the reboot action.
+type rebootActionModel struct {
+	FactoryReset types.Bool   `tfsdk:"factory_reset"`
+	ReadyTimeout types.String `tfsdk:"ready_timeout"`
+	Device       types.String `tfsdk:"device"`
+}
@@ -0,0 +1,84 @@
+package reboot_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	// Import the test helper package.
+	"github.com/brennoo/terraform-provider-hrui/internal/provider"
+)
+
+// TestAccRebootResource provides an acceptance test for the hrui_reboot
+// resource: creating it reboots the switch and waits until it accepts
+// logins again.
+func TestAccRebootResource(t *testing.T) {
+	providerFactories := provider.TestAccProtoV6ProviderFactories(t, "reboot_resource_test")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			// Step 1: Reboot the switch
+			{
+				Config: testAccRebootResourceConfig("1m"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("hrui_reboot.test", "id"),
+					resource.TestCheckResourceAttr("hrui_reboot.test", "factory_reset", "false"),
+					resource.TestCheckResourceAttr("hrui_reboot.test", "ready_timeout", "1m"),
+				),
+			},
+			// Step 2: Change ready_timeout; the cassette has a single
+			// reboot.cgi interaction, so a second reboot fails
+			{
+				Config: testAccRebootResourceConfig("2m"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("hrui_reboot.test", "ready_timeout", "2m"),
+				),
+			},
+			// Destroying the resource does not reboot the switch.
+		},
+	})
+}
+
+// TestAccRebootResource_FactoryReset provides an acceptance test for a
+// factory reset. The switch comes back with its default address and
+// credentials, so the test only replays its cassette.
+func TestAccRebootResource_FactoryReset(t *testing.T) {
+	if mode := os.Getenv("VCR_MODE"); mode == "record" || mode == "passthrough" {
+		t.Skip("the factory reset test cannot be recorded without losing the switch configuration")
+	}
+	providerFactories := provider.TestAccProtoV6ProviderFactories(t, "reboot_resource_factory_reset_test")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			// Step 1: Reset the switch; the provider does not wait for it
+			{
+				Config: `
+provider "hrui" {}
+
+resource "hrui_reboot" "test" {
+  factory_reset = true
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("hrui_reboot.test", "id"),
+					resource.TestCheckResourceAttr("hrui_reboot.test", "factory_reset", "true"),
+				),
+			},
+		},
+	})
+}
+
+// testAccRebootResourceConfig generates the HCL for the hrui_reboot resource.
+func testAccRebootResourceConfig(readyTimeout string) string {
+	return fmt.Sprintf(`
+provider "hrui" {}
+
+resource "hrui_reboot" "test" {
+  ready_timeout = %q
+}
+`, readyTimeout)
+}
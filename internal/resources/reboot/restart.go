@@ -0,0 +1,73 @@
+package reboot
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/brennoo/terraform-provider-hrui/internal/sdk"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	factoryResetDescription = "Reset the switch to its factory defaults instead of only rebooting it. " +
+		"The switch comes back with its default IP address and credentials, so the provider does not wait for it. Defaults to `false`."
+	readyTimeoutDescription = "How long to wait for the switch to accept logins again after the reboot, as a Go duration string (e.g. `10m`). Defaults to `5m`."
+)
+
+// restartSwitch reboots or factory-resets the switch. After a reboot it waits until the switch
+// accepts logins again. progress reports each step to the practitioner.
+func restartSwitch(ctx context.Context, client *sdk.HRUIClient, factoryReset types.Bool, readyTimeout types.String, progress func(string), diags *diag.Diagnostics) {
+	timeout := sdk.DefaultReadyTimeout
+	if !readyTimeout.IsNull() && !readyTimeout.IsUnknown() {
+		var err error
+		timeout, err = time.ParseDuration(readyTimeout.ValueString())
+		if err != nil || timeout <= 0 {
+			diags.AddAttributeError(
+				path.Root("ready_timeout"),
+				"Invalid Ready Timeout",
+				fmt.Sprintf("ready_timeout must be a positive duration such as 10m, got %q.", readyTimeout.ValueString()),
+			)
+			return
+		}
+	}
+
+	if factoryReset.ValueBool() {
+		progress("Resetting the switch to factory defaults")
+		if err := client.FactoryReset(ctx); err != nil {
+			diags.AddError(
+				"Error Resetting Switch",
+				fmt.Sprintf("Failed to reset the switch to factory defaults: %s", err),
+			)
+			return
+		}
+		diags.AddWarning(
+			"Switch Reset to Factory Defaults",
+			"The switch is restarting with its default IP address and credentials. Update the provider configuration before the next run.",
+		)
+		return
+	}
+
+	progress("Rebooting the switch")
+	if err := client.Reboot(ctx); err != nil {
+		diags.AddError(
+			"Error Rebooting Switch",
+			fmt.Sprintf("Failed to reboot the switch: %s", err),
+		)
+		return
+	}
+
+	progress(fmt.Sprintf("Waiting up to %s for the switch to come back", timeout))
+	if err := client.WaitForReady(ctx, timeout, 0); err != nil {
+		diags.AddError(
+			"Error Waiting for Switch",
+			fmt.Sprintf("The switch did not come back after the reboot: %s", err),
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "Switch is back after reboot")
+}
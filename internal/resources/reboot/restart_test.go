@@ -0,0 +1,152 @@
+package reboot
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/brennoo/terraform-provider-hrui/internal/providerutil"
+	"github.com/brennoo/terraform-provider-hrui/internal/sdk"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// restartServer emulates a switch that does not come back after a restart: once reboot.cgi
+// or reset.cgi is posted, every request fails. It records the paths of all POST requests.
+type restartServer struct {
+	*httptest.Server
+
+	mu    sync.Mutex
+	down  bool
+	posts []string
+}
+
+func newRestartServer(t *testing.T) (*restartServer, *sdk.HRUIClient) {
+	rs := &restartServer{}
+	rs.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		rs.mu.Lock()
+		defer rs.mu.Unlock()
+
+		if rs.down {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		if r.Method != http.MethodPost {
+			return
+		}
+		rs.posts = append(rs.posts, r.URL.Path)
+		if r.URL.Path == "/reboot.cgi" || r.URL.Path == "/reset.cgi" {
+			rs.down = true
+		}
+	}))
+	t.Cleanup(rs.Close)
+
+	client, err := sdk.NewClient(context.Background(), rs.URL, "admin", "secret", true, nil,
+		sdk.WithFirmwareDetection(false), sdk.WithRetryPolicy(sdk.RetryPolicy{MaxAttempts: 1}))
+	require.NoError(t, err)
+	return rs, client
+}
+
+func (rs *restartServer) postedPaths() []string {
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+	return append([]string(nil), rs.posts...)
+}
+
+func TestRestartSwitch_ReadyTimeout(t *testing.T) {
+	server, client := newRestartServer(t)
+
+	var progress []string
+	var diags diag.Diagnostics
+	restartSwitch(context.Background(), client, types.BoolValue(false), types.StringValue("50ms"), func(message string) {
+		progress = append(progress, message)
+	}, &diags)
+
+	require.True(t, diags.HasError())
+	assert.Equal(t, "Error Waiting for Switch", diags.Errors()[0].Summary())
+	assert.Contains(t, diags.Errors()[0].Detail(), "switch not ready within 50ms")
+	assert.Equal(t, []string{"/login.cgi", "/reboot.cgi"}, server.postedPaths())
+	assert.Equal(t, []string{"Rebooting the switch", "Waiting up to 50ms for the switch to come back"}, progress)
+}
+
+func TestRestartSwitch_FactoryReset(t *testing.T) {
+	server, client := newRestartServer(t)
+
+	var diags diag.Diagnostics
+	restartSwitch(context.Background(), client, types.BoolValue(true), types.StringNull(), func(string) {}, &diags)
+
+	require.False(t, diags.HasError(), diags)
+	require.Len(t, diags.Warnings(), 1)
+	assert.Equal(t, "Switch Reset to Factory Defaults", diags.Warnings()[0].Summary())
+	assert.Equal(t, []string{"/login.cgi", "/reset.cgi"}, server.postedPaths(), "a reset switch is not waited for")
+}
+
+func TestRestartSwitch_InvalidReadyTimeout(t *testing.T) {
+	for _, timeout := range []string{"soon", "0s", "-1m"} {
+		t.Run(timeout, func(t *testing.T) {
+			server, client := newRestartServer(t)
+
+			var diags diag.Diagnostics
+			restartSwitch(context.Background(), client, types.BoolValue(false), types.StringValue(timeout), func(string) {}, &diags)
+
+			require.True(t, diags.HasError())
+			assert.Equal(t, "Invalid Ready Timeout", diags.Errors()[0].Summary())
+			assert.Equal(t, []string{"/login.cgi"}, server.postedPaths(), "the switch must not be restarted")
+		})
+	}
+}
+
+// invokeAction invokes the reboot action with the given configuration and returns the progress messages.
+func invokeAction(t *testing.T, client *sdk.HRUIClient, factoryReset tftypes.Value, readyTimeout tftypes.Value) ([]string, diag.Diagnostics) {
+	t.Helper()
+	ctx := context.Background()
+
+	a := &rebootAction{devices: providerutil.NewDevices(client)}
+	var schemaResp action.SchemaResponse
+	a.Schema(ctx, action.SchemaRequest{}, &schemaResp)
+	require.False(t, schemaResp.Diagnostics.HasError(), schemaResp.Diagnostics)
+
+	config := tfsdk.Config{
+		Schema: schemaResp.Schema,
+		Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), map[string]tftypes.Value{
+			"device":        tftypes.NewValue(tftypes.String, nil),
+			"factory_reset": factoryReset,
+			"ready_timeout": readyTimeout,
+		}),
+	}
+
+	var progress []string
+	resp := action.InvokeResponse{SendProgress: func(event action.InvokeProgressEvent) {
+		progress = append(progress, event.Message)
+	}}
+	a.Invoke(ctx, action.InvokeRequest{Config: config}, &resp)
+	return progress, resp.Diagnostics
+}
+
+func TestRebootAction_Invoke(t *testing.T) {
+	server, client := newRestartServer(t)
+
+	progress, diags := invokeAction(t, client, tftypes.NewValue(tftypes.Bool, nil), tftypes.NewValue(tftypes.String, "50ms"))
+
+	require.True(t, diags.HasError())
+	assert.Contains(t, diags.Errors()[0].Detail(), "switch not ready within 50ms")
+	assert.Equal(t, []string{"/login.cgi", "/reboot.cgi"}, server.postedPaths())
+	assert.Equal(t, []string{"Rebooting the switch", "Waiting up to 50ms for the switch to come back"}, progress)
+}
+
+func TestRebootAction_InvokeFactoryReset(t *testing.T) {
+	server, client := newRestartServer(t)
+
+	progress, diags := invokeAction(t, client, tftypes.NewValue(tftypes.Bool, true), tftypes.NewValue(tftypes.String, nil))
+
+	require.False(t, diags.HasError(), diags)
+	assert.Equal(t, []string{"/login.cgi", "/reset.cgi"}, server.postedPaths())
+	assert.Equal(t, []string{"Resetting the switch to factory defaults"}, progress)
+}
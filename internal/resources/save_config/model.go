@@ -0,0 +1,13 @@
+package save_config
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// saveConfigModel represents the state model for the save config Terraform resource.
+type saveConfigModel struct {
+	ID       types.String `tfsdk:"id"`
+	Triggers types.Map    `tfsdk:"triggers"`
+	SavedAt  types.String `tfsdk:"saved_at"`
+	Device   types.String `tfsdk:"device"`
+}
@@ -0,0 +1,182 @@
+package save_config
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/brennoo/terraform-provider-hrui/internal/providerutil"
+	"github.com/brennoo/terraform-provider-hrui/internal/sdk"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the required interfaces.
+var (
+	_ resource.Resource               = &saveConfigResource{}
+	_ resource.ResourceWithConfigure  = &saveConfigResource{}
+	_ resource.ResourceWithModifyPlan = &saveConfigResource{}
+)
+
+// saveConfigResource saves the running configuration of the switch to flash.
+type saveConfigResource struct {
+	client  *sdk.HRUIClient
+	devices *providerutil.Devices
+}
+
+// NewResource creates a new instance of the save config resource.
+func NewResource() resource.Resource {
+	return &saveConfigResource{}
+}
+
+// Metadata sets the resource name for Terraform.
+func (r *saveConfigResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_save_config"
+}
+
+// Schema defines the schema for the save config resource.
+func (r *saveConfigResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Saves the running configuration of the switch to flash.",
+		MarkdownDescription: "Saves the running configuration of the switch to flash. " +
+			"Intended for use with `autosave_mode = \"batch\"`, where changes are only written by this resource. " +
+			"The resource is updated on every apply and then saves the changes made so far; in batch mode the save is skipped when nothing changed. " +
+			"`depends_on` must list every resource of the switch, so their changes are made before the save; changes made after the save are lost when the switch restarts. " +
+			"When the resource is destroyed, the pending changes are saved and the changes made by the rest of the destroy are saved immediately.",
+		Attributes: map[string]schema.Attribute{
+			"device": providerutil.DeviceResourceAttribute(),
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Timestamp of the last save triggered by this resource.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"saved_at": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Time of the last apply that saved the configuration. Unknown in every plan, so the configuration is saved on every apply.",
+			},
+			"triggers": schema.MapAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				MarkdownDescription: "Arbitrary map of values that, when changed, will save the configuration again.",
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+// Configure assigns the provider-configured client to the resource.
+func (r *saveConfigResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.devices = providerutil.ConfigureDevices(req.ProviderData, &resp.Diagnostics)
+}
+
+// ModifyPlan marks saved_at unknown, so the resource is updated and saves on every apply.
+func (r *saveConfigResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("saved_at"), types.StringUnknown())...)
+}
+
+// Create saves the configuration.
+func (r *saveConfigResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	r.client = r.devices.Client(ctx, req.Plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer providerutil.KeepDevice(ctx, req.Plan, &resp.State, &resp.Diagnostics)
+
+	var plan saveConfigModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.save(ctx); err != nil {
+		resp.Diagnostics.AddError("Error Saving Configuration", fmt.Sprintf("Failed to save configuration: %s", err))
+		return
+	}
+
+	plan.ID = types.StringValue(time.Now().UTC().Format(time.RFC3339))
+	plan.SavedAt = plan.ID
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+
+	tflog.Debug(ctx, "Configuration saved")
+}
+
+// Read keeps the current state, as there is nothing to read back from the device.
+func (r *saveConfigResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	r.client = r.devices.Client(ctx, req.State, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer providerutil.KeepDevice(ctx, req.State, &resp.State, &resp.Diagnostics)
+
+	var state saveConfigModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update saves the changes made by the current apply, see ModifyPlan.
+func (r *saveConfigResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	r.client = r.devices.Client(ctx, req.Plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer providerutil.KeepDevice(ctx, req.Plan, &resp.State, &resp.Diagnostics)
+
+	var plan saveConfigModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.save(ctx); err != nil {
+		resp.Diagnostics.AddError("Error Saving Configuration", fmt.Sprintf("Failed to save configuration: %s", err))
+		return
+	}
+
+	plan.SavedAt = types.StringValue(time.Now().UTC().Format(time.RFC3339))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete saves the pending changes. The resource depends on the resources it saves, so it is
+// destroyed before them; in batch mode their changes are then saved immediately.
+func (r *saveConfigResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	r.client = r.devices.Client(ctx, req.State, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Removing save config resource from state")
+
+	if !r.client.Batching() {
+		return
+	}
+	if err := r.client.EndBatch(ctx); err != nil {
+		resp.Diagnostics.AddError("Error Saving Configuration", fmt.Sprintf("Failed to save configuration: %s", err))
+	}
+}
+
+// save writes the configuration to flash. In batch mode it is skipped if nothing changed.
+func (r *saveConfigResource) save(ctx context.Context) error {
+	tflog.Debug(ctx, "Saving configuration", map[string]any{"pending_changes": r.client.HasPendingChanges()})
+
+	if r.client.Batching() {
+		return r.client.CommitPendingChanges(ctx)
+	}
+	return r.client.CommitChanges(ctx)
+}
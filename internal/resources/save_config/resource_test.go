@@ -0,0 +1,59 @@
+package save_config_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	// Import the test helper package.
+	"github.com/brennoo/terraform-provider-hrui/internal/provider"
+)
+
+// TestAccSaveConfigResource provides an acceptance test for the hrui_save_config
+// resource in batch autosave mode: hrui_eee changes the switch without saving,
+// and hrui_save_config writes the change to flash once.
+func TestAccSaveConfigResource(t *testing.T) {
+	providerFactories := provider.TestAccProtoV6ProviderFactories(t, "save_config_resource_test")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			// Step 1: Create hrui_eee and save its change
+			{
+				Config: testAccSaveConfigResourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("hrui_eee.test", "enabled", "true"),
+					resource.TestCheckResourceAttrSet("hrui_save_config.test", "id"),
+					resource.TestCheckResourceAttrPair("hrui_save_config.test", "saved_at", "hrui_save_config.test", "id"),
+				),
+				// saved_at is unknown in every plan, so the configuration is saved on every apply.
+				ExpectNonEmptyPlan: true,
+			},
+			// Step 2: The plan of the next apply saves again
+			{
+				Config:             testAccSaveConfigResourceConfig,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			// The `resource.Test` harness runs a `terraform destroy`
+			// at the end: hrui_save_config is destroyed first and
+			// ends the batch, so disabling EEE is saved immediately.
+		},
+	})
+}
+
+// testAccSaveConfigResourceConfig manages EEE in batch autosave mode
+// and saves the change with hrui_save_config.
+const testAccSaveConfigResourceConfig = `
+provider "hrui" {
+  autosave_mode = "batch"
+}
+
+resource "hrui_eee" "test" {
+  enabled = true
+}
+
+resource "hrui_save_config" "test" {
+  depends_on = [hrui_eee.test]
+}
+`
@@ -8,4 +8,5 @@ type stormControlModel struct {
 	StormType types.String `tfsdk:"storm_type"`
 	State     types.Bool   `tfsdk:"state"`
 	Rate      types.Int64  `tfsdk:"rate"`
+	Device    types.String `tfsdk:"device"`
 }
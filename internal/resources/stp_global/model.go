@@ -17,4 +17,5 @@ type stpGlobalModel struct {
 	RootMAC      types.String `tfsdk:"root_mac"`
 	RootPathCost types.Int64  `tfsdk:"root_path_cost"`
 	RootPort     types.String `tfsdk:"root_port"`
+	Device       types.String `tfsdk:"device"`
 }
@@ -22,7 +22,8 @@ var (
 
 // stpGlobalResource implements the resource for STP Global configuration.
 type stpGlobalResource struct {
-	client *sdk.HRUIClient
+	client  *sdk.HRUIClient
+	devices *providerutil.Devices
 }
 
 // NewResource is a constructor for the STP Global resource.
@@ -40,6 +41,7 @@ func (r *stpGlobalResource) Schema(_ context.Context, _ resource.SchemaRequest,
 	resp.Schema = schema.Schema{
 		Description: "Manages global STP settings.",
 		Attributes: map[string]schema.Attribute{
+			"device": providerutil.DeviceResourceAttribute(),
 			"stp_status": schema.StringAttribute{
 				Description: "Specifies whether STP is enabled or disabled. This is read-only.",
 				Computed:    true,
@@ -82,11 +84,17 @@ func (r *stpGlobalResource) Schema(_ context.Context, _ resource.SchemaRequest,
 
 // Configure binds the provider's client to this resource.
 func (r *stpGlobalResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	r.client = providerutil.ConfigureClient(req.ProviderData, &resp.Diagnostics)
+	r.devices = providerutil.ConfigureDevices(req.ProviderData, &resp.Diagnostics)
 }
 
 // Create provisions the STP Global settings using the provider.
 func (r *stpGlobalResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	r.client = r.devices.Client(ctx, req.Plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer providerutil.KeepDevice(ctx, req.Plan, &resp.State, &resp.Diagnostics)
+
 	tflog.Debug(ctx, "Creating STP global settings")
 
 	// Extract the desired configuration from the Terraform plan
@@ -152,6 +160,12 @@ func (r *stpGlobalResource) Create(ctx context.Context, req resource.CreateReque
 
 // Read fetches the current state for hrui_stp_global.
 func (r *stpGlobalResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	r.client = r.devices.Client(ctx, req.State, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer providerutil.KeepDevice(ctx, req.State, &resp.State, &resp.Diagnostics)
+
 	tflog.Debug(ctx, "Reading STP global settings")
 
 	var state stpGlobalModel
@@ -195,6 +209,12 @@ func (r *stpGlobalResource) Read(ctx context.Context, req resource.ReadRequest,
 
 // Update modifies the STP global settings.
 func (r *stpGlobalResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	r.client = r.devices.Client(ctx, req.Plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer providerutil.KeepDevice(ctx, req.Plan, &resp.State, &resp.Diagnostics)
+
 	tflog.Debug(ctx, "Updating STP global settings")
 
 	// Extract the desired plan from the Terraform configuration
@@ -260,6 +280,11 @@ func (r *stpGlobalResource) Update(ctx context.Context, req resource.UpdateReque
 
 // Delete disables STP by setting STPStatus to "Disable" and clears state.
 func (r *stpGlobalResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	r.client = r.devices.Client(ctx, req.State, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	tflog.Debug(ctx, "Deleting STP global settings")
 
 	stpSettings := sdk.STPGlobalSettings{
@@ -277,6 +302,14 @@ func (r *stpGlobalResource) Delete(ctx context.Context, req resource.DeleteReque
 
 // ImportState imports an existing STP Global resource by fetching the current state.
 func (r *stpGlobalResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	device, importID := providerutil.SplitImportID(req.ID)
+	r.client = r.devices.Get(ctx, device, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer providerutil.SetDevice(ctx, device, &resp.State, &resp.Diagnostics)
+	req.ID = importID
+
 	tflog.Debug(ctx, "Importing STP global settings", map[string]any{"id": req.ID})
 
 	stpFromBackend, err := r.client.GetSTPSettings(ctx)
@@ -13,4 +13,5 @@ type stpPortModel struct {
 	Edge     types.String `tfsdk:"edge"`
 	State    types.String `tfsdk:"state"`
 	Role     types.String `tfsdk:"role"`
+	Device   types.String `tfsdk:"device"`
 }
@@ -24,7 +24,8 @@ var (
 )
 
 type stpPortResource struct {
-	client *sdk.HRUIClient
+	client  *sdk.HRUIClient
+	devices *providerutil.Devices
 }
 
 // NewResource creates a new instance of the resource.
@@ -42,6 +43,7 @@ func (r *stpPortResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 	resp.Schema = schema.Schema{
 		Description: "Manages STP port settings.",
 		Attributes: map[string]schema.Attribute{
+			"device": providerutil.DeviceResourceAttribute(),
 			"port": schema.StringAttribute{
 				Description: "The port name to configure STP. Changing this will recreate the resource.",
 				Required:    true,
@@ -83,11 +85,17 @@ func (r *stpPortResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 
 // Configure sets up the client for the resource.
 func (r *stpPortResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	r.client = providerutil.ConfigureClient(req.ProviderData, &resp.Diagnostics)
+	r.devices = providerutil.ConfigureDevices(req.ProviderData, &resp.Diagnostics)
 }
 
 // Create provisions the STP port settings and synchronizes the Terraform state.
 func (r *stpPortResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	r.client = r.devices.Client(ctx, req.Plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer providerutil.KeepDevice(ctx, req.Plan, &resp.State, &resp.Diagnostics)
+
 	var plan stpPortModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -124,6 +132,12 @@ func (r *stpPortResource) Create(ctx context.Context, req resource.CreateRequest
 
 // Read fetches the current state of the STP port from the backend.
 func (r *stpPortResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	r.client = r.devices.Client(ctx, req.State, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer providerutil.KeepDevice(ctx, req.State, &resp.State, &resp.Diagnostics)
+
 	var state stpPortModel
 
 	diags := req.State.Get(ctx, &state)
@@ -146,6 +160,12 @@ func (r *stpPortResource) Read(ctx context.Context, req resource.ReadRequest, re
 
 // Update modifies the STP port settings in the backend.
 func (r *stpPortResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	r.client = r.devices.Client(ctx, req.Plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer providerutil.KeepDevice(ctx, req.Plan, &resp.State, &resp.Diagnostics)
+
 	var plan stpPortModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -182,6 +202,11 @@ func (r *stpPortResource) Update(ctx context.Context, req resource.UpdateRequest
 
 // Delete resets the STP port settings and removes the resource from the state.
 func (r *stpPortResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	r.client = r.devices.Client(ctx, req.State, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var state stpPortModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -211,6 +236,14 @@ func (r *stpPortResource) Delete(ctx context.Context, req resource.DeleteRequest
 
 // ImportState imports an existing STP Port resource by port name.
 func (r *stpPortResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	device, importID := providerutil.SplitImportID(req.ID)
+	r.client = r.devices.Get(ctx, device, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer providerutil.SetDevice(ctx, device, &resp.State, &resp.Diagnostics)
+	req.ID = importID
+
 	tflog.Debug(ctx, "Importing STP port settings", map[string]any{"id": req.ID})
 
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("port"), req.ID)...)
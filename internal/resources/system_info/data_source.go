@@ -18,7 +18,8 @@ var (
 
 // systemInfoDataSource is the data source implementation.
 type systemInfoDataSource struct {
-	client *sdk.HRUIClient
+	client  *sdk.HRUIClient
+	devices *providerutil.Devices
 }
 
 // NewDataSource is a helper function to simplify the provider implementation.
@@ -28,7 +29,7 @@ func NewDataSource() datasource.DataSource {
 
 // Configure assigns the provider-configured client to the data source.
 func (d *systemInfoDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
-	d.client = providerutil.ConfigureClient(req.ProviderData, &resp.Diagnostics)
+	d.devices = providerutil.ConfigureDevices(req.ProviderData, &resp.Diagnostics)
 }
 
 // Metadata defines the schema type name.
@@ -38,6 +39,12 @@ func (d *systemInfoDataSource) Metadata(ctx context.Context, req datasource.Meta
 
 // Read retrieves data from the HRUI system using the HRUIClient and parses the system information.
 func (d *systemInfoDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	d.client = d.devices.Client(ctx, req.Config, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer providerutil.KeepDevice(ctx, req.Config, &resp.State, &resp.Diagnostics)
+
 	var data systemInfoModel
 
 	if d.client == nil {
@@ -55,14 +62,16 @@ func (d *systemInfoDataSource) Read(ctx context.Context, req datasource.ReadRequ
 	}
 
 	// Map the systemInfo data to your data model.
-	data.DeviceModel = types.StringValue(systemInfo["Device Model"])
-	data.MACAddress = types.StringValue(systemInfo["MAC Address"])
-	data.IPAddress = types.StringValue(systemInfo["IP Address"])
-	data.Netmask = types.StringValue(systemInfo["Netmask"])
-	data.Gateway = types.StringValue(systemInfo["Gateway"])
-	data.FirmwareVersion = types.StringValue(systemInfo["Firmware Version"])
-	data.FirmwareDate = types.StringValue(systemInfo["Firmware Date"])
-	data.HardwareVersion = types.StringValue(systemInfo["Hardware Version"])
+	data.DeviceModel = types.StringValue(systemInfo.DeviceModel)
+	data.MACAddress = types.StringValue(systemInfo.MACAddress)
+	data.IPAddress = types.StringValue(systemInfo.IPAddress)
+	data.Netmask = types.StringValue(systemInfo.Netmask)
+	data.Gateway = types.StringValue(systemInfo.Gateway)
+	data.FirmwareVersion = types.StringValue(systemInfo.FirmwareVersion)
+	data.FirmwareDate = types.StringValue(systemInfo.FirmwareDate)
+	data.HardwareVersion = types.StringValue(systemInfo.HardwareVersion)
+	data.SerialNumber = optionalString(systemInfo.SerialNumber)
+	data.Uptime = optionalString(systemInfo.Uptime)
 
 	// Set the ID (using MAC address as the unique identifier).
 	id := data.MACAddress.ValueString()
@@ -75,3 +84,11 @@ func (d *systemInfoDataSource) Read(ctx context.Context, req datasource.ReadRequ
 		return
 	}
 }
+
+// optionalString returns a null string for fields the firmware does not show.
+func optionalString(value string) types.String {
+	if value == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(value)
+}
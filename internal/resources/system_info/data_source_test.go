@@ -25,6 +25,8 @@ func TestAccSystemInfoDataSource(t *testing.T) {
 					resource.TestCheckResourceAttrSet("data.hrui_system_info.test", "firmware_version"),
 					resource.TestCheckResourceAttrSet("data.hrui_system_info.test", "firmware_date"),
 					resource.TestCheckResourceAttrSet("data.hrui_system_info.test", "hardware_version"),
+					resource.TestCheckNoResourceAttr("data.hrui_system_info.test", "serial_number"),
+					resource.TestCheckNoResourceAttr("data.hrui_system_info.test", "uptime"),
 				),
 			},
 		},
@@ -14,4 +14,7 @@ type systemInfoModel struct {
 	FirmwareVersion types.String `tfsdk:"firmware_version"`
 	FirmwareDate    types.String `tfsdk:"firmware_date"`
 	HardwareVersion types.String `tfsdk:"hardware_version"`
+	SerialNumber    types.String `tfsdk:"serial_number"`
+	Uptime          types.String `tfsdk:"uptime"`
+	Device          types.String `tfsdk:"device"`
 }
@@ -3,6 +3,7 @@ package system_info
 import (
 	"context"
 
+	"github.com/brennoo/terraform-provider-hrui/internal/providerutil"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 )
@@ -11,6 +12,7 @@ func (d *systemInfoDataSource) Schema(ctx context.Context, req datasource.Schema
 	resp.Schema = schema.Schema{
 		Description: "Data source to retrieve the system information.",
 		Attributes: map[string]schema.Attribute{
+			"device": providerutil.DeviceDataSourceAttribute(),
 			"id": schema.StringAttribute{
 				Computed:    true,
 				Description: "The ID of the system info resource.",
@@ -21,7 +23,7 @@ func (d *systemInfoDataSource) Schema(ctx context.Context, req datasource.Schema
 			},
 			"mac_address": schema.StringAttribute{
 				Computed:    true,
-				Description: "The MAC address of the HRUI switch.",
+				Description: "The MAC address of the HRUI switch, in upper case with colon separators.",
 			},
 			"ip_address": schema.StringAttribute{
 				Computed:    true,
@@ -47,6 +49,14 @@ func (d *systemInfoDataSource) Schema(ctx context.Context, req datasource.Schema
 				Computed:    true,
 				Description: "The hardware version of the HRUI switch.",
 			},
+			"serial_number": schema.StringAttribute{
+				Computed:    true,
+				Description: "The serial number of the HRUI switch. Null if the firmware does not show it.",
+			},
+			"uptime": schema.StringAttribute{
+				Computed:    true,
+				Description: "The uptime of the HRUI switch as shown by the web interface. Null if the firmware does not show it.",
+			},
 		},
 	}
 }
@@ -4,7 +4,8 @@ import "github.com/hashicorp/terraform-plugin-framework/types"
 
 // trunkGroupModel represents the resource schema state.
 type trunkGroupModel struct {
-	ID    types.Int64  `tfsdk:"id"`
-	Type  types.String `tfsdk:"type"`
-	Ports types.List   `tfsdk:"ports"`
+	ID     types.Int64  `tfsdk:"id"`
+	Type   types.String `tfsdk:"type"`
+	Ports  types.List   `tfsdk:"ports"`
+	Device types.String `tfsdk:"device"`
 }
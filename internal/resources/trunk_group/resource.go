@@ -2,6 +2,7 @@ package trunk_group
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strconv"
 	"strings"
@@ -26,7 +27,8 @@ var (
 
 // trunkGroupResource manages trunk groups on the HRUI switch.
 type trunkGroupResource struct {
-	client *sdk.HRUIClient
+	client  *sdk.HRUIClient
+	devices *providerutil.Devices
 }
 
 // NewResource creates a new instance of the trunk group resource.
@@ -44,6 +46,7 @@ func (r *trunkGroupResource) Schema(ctx context.Context, req resource.SchemaRequ
 	resp.Schema = schema.Schema{
 		Description: "Manages trunk group settings.",
 		Attributes: map[string]schema.Attribute{
+			"device": providerutil.DeviceResourceAttribute(),
 			"id": schema.Int64Attribute{
 				Description: "The trunk group ID. Must match one of the available trunk group IDs on the device.",
 				Required:    true,
@@ -66,11 +69,17 @@ func (r *trunkGroupResource) Schema(ctx context.Context, req resource.SchemaRequ
 
 // Configure assigns the SDK client from provider configuration.
 func (r *trunkGroupResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	r.client = providerutil.ConfigureClient(req.ProviderData, &resp.Diagnostics)
+	r.devices = providerutil.ConfigureDevices(req.ProviderData, &resp.Diagnostics)
 }
 
 // Create a new trunk group.
 func (r *trunkGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	r.client = r.devices.Client(ctx, req.Plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer providerutil.KeepDevice(ctx, req.Plan, &resp.State, &resp.Diagnostics)
+
 	var data trunkGroupModel
 	diags := req.Plan.Get(ctx, &data)
 	resp.Diagnostics.Append(diags...)
@@ -158,6 +167,9 @@ func (r *trunkGroupResource) Create(ctx context.Context, req resource.CreateRequ
 		Ports: sdkPorts,
 	})
 	if err != nil {
+		if providerutil.AddUnsupportedFeatureError(&resp.Diagnostics, err) {
+			return
+		}
 		resp.Diagnostics.AddError("Error Creating Trunk Group", err.Error())
 		return
 	}
@@ -198,6 +210,12 @@ func (r *trunkGroupResource) Create(ctx context.Context, req resource.CreateRequ
 
 // Read retrieves the trunk group details.
 func (r *trunkGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	r.client = r.devices.Client(ctx, req.State, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer providerutil.KeepDevice(ctx, req.State, &resp.State, &resp.Diagnostics)
+
 	var state trunkGroupModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -209,6 +227,11 @@ func (r *trunkGroupResource) Read(ctx context.Context, req resource.ReadRequest,
 
 	// Fetch trunk group details from the SDK
 	trunkGroup, err := r.client.GetTrunk(ctx, int(state.ID.ValueInt64()))
+	if errors.Is(err, sdk.ErrNotFound) {
+		tflog.Warn(ctx, "Trunk group no longer exists on the device, removing from state", map[string]any{"id": state.ID.ValueInt64()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError("Error Reading Trunk Group", err.Error())
 		return
@@ -231,6 +254,12 @@ func (r *trunkGroupResource) Read(ctx context.Context, req resource.ReadRequest,
 
 // Update modifies an existing trunk group.
 func (r *trunkGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	r.client = r.devices.Client(ctx, req.Plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer providerutil.KeepDevice(ctx, req.Plan, &resp.State, &resp.Diagnostics)
+
 	var plan trunkGroupModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -295,6 +324,9 @@ func (r *trunkGroupResource) Update(ctx context.Context, req resource.UpdateRequ
 		Ports: sdkPorts,
 	})
 	if err != nil {
+		if providerutil.AddUnsupportedFeatureError(&resp.Diagnostics, err) {
+			return
+		}
 		resp.Diagnostics.AddError("Error Updating Trunk Group", err.Error())
 		return
 	}
@@ -335,6 +367,11 @@ func (r *trunkGroupResource) Update(ctx context.Context, req resource.UpdateRequ
 
 // Delete removes a trunk group.
 func (r *trunkGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	r.client = r.devices.Client(ctx, req.State, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var state trunkGroupModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -353,6 +390,14 @@ func (r *trunkGroupResource) Delete(ctx context.Context, req resource.DeleteRequ
 
 // ImportState imports an existing Trunk Group resource by numeric trunk ID.
 func (r *trunkGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	device, importID := providerutil.SplitImportID(req.ID)
+	r.client = r.devices.Get(ctx, device, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer providerutil.SetDevice(ctx, device, &resp.State, &resp.Diagnostics)
+	req.ID = importID
+
 	tflog.Debug(ctx, "Importing trunk group", map[string]any{"id": req.ID})
 
 	id, err := strconv.ParseInt(req.ID, 10, 64)
@@ -13,7 +13,8 @@ import (
 
 // vlan8021qDataSource defines the VLAN data source.
 type vlan8021qDataSource struct {
-	client *sdk.HRUIClient
+	client  *sdk.HRUIClient
+	devices *providerutil.Devices
 }
 
 // Ensure that vlan8021qDataSource implements the datasource.DataSource interface.
@@ -34,6 +35,7 @@ func (d *vlan8021qDataSource) Schema(ctx context.Context, req datasource.SchemaR
 	resp.Schema = schema.Schema{
 		Description: "Data source for retrieving 802.1Q VLAN settings.",
 		Attributes: map[string]schema.Attribute{
+			"device": providerutil.DeviceDataSourceAttribute(),
 			"vlan_id": schema.Int64Attribute{
 				Required:            true,
 				MarkdownDescription: "VLAN ID (1-4094) used to query the VLAN.",
@@ -62,11 +64,17 @@ func (d *vlan8021qDataSource) Schema(ctx context.Context, req datasource.SchemaR
 }
 
 func (d *vlan8021qDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
-	d.client = providerutil.ConfigureClient(req.ProviderData, &resp.Diagnostics)
+	d.devices = providerutil.ConfigureDevices(req.ProviderData, &resp.Diagnostics)
 }
 
 // Read fetches the VLAN information and sets it in the Terraform state.
 func (d *vlan8021qDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	d.client = d.devices.Client(ctx, req.Config, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer providerutil.KeepDevice(ctx, req.Config, &resp.State, &resp.Diagnostics)
+
 	var model vlan8021qModel
 
 	// Retrieve the VLAN ID from the user input.
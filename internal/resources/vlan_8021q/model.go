@@ -11,4 +11,5 @@ type vlan8021qModel struct {
 	UntaggedPorts types.List   `tfsdk:"untagged_ports"`
 	TaggedPorts   types.List   `tfsdk:"tagged_ports"`
 	MemberPorts   types.List   `tfsdk:"member_ports"`
+	Device        types.String `tfsdk:"device"`
 }
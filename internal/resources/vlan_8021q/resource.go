@@ -2,7 +2,9 @@ package vlan_8021q
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"slices"
 	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -22,11 +24,13 @@ var (
 	_ resource.Resource                = &vlan8021qResource{}
 	_ resource.ResourceWithConfigure   = &vlan8021qResource{}
 	_ resource.ResourceWithImportState = &vlan8021qResource{}
+	_ resource.ResourceWithModifyPlan  = &vlan8021qResource{}
 )
 
 // vlan8021qResource defines the VLAN resource using *sdk.HRUIClient.
 type vlan8021qResource struct {
-	client *sdk.HRUIClient
+	client  *sdk.HRUIClient
+	devices *providerutil.Devices
 }
 
 // NewResource creates a new VLAN resource instance.
@@ -42,6 +46,7 @@ func (r *vlan8021qResource) Schema(_ context.Context, _ resource.SchemaRequest,
 	resp.Schema = schema.Schema{
 		Description: "Configures 802.1Q VLAN settings.",
 		Attributes: map[string]schema.Attribute{
+			"device": providerutil.DeviceResourceAttribute(),
 			"vlan_id": schema.Int64Attribute{
 				Required:            true,
 				MarkdownDescription: "VLAN ID (1-4094). The unique identifier for the VLAN.",
@@ -73,7 +78,51 @@ func (r *vlan8021qResource) Schema(_ context.Context, _ resource.SchemaRequest,
 }
 
 func (r *vlan8021qResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	r.client = providerutil.ConfigureClient(req.ProviderData, &resp.Diagnostics)
+	r.devices = providerutil.ConfigureDevices(req.ProviderData, &resp.Diagnostics)
+}
+
+// ModifyPlan fails the plan if the switch is not in 802.1Q VLAN mode, and warns about ports that
+// use the VLAN as PVID but are not untagged members of it.
+func (r *vlan8021qResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	providerutil.RequireVLANMode(ctx, r.devices, req.Plan, req.State, sdk.VLANMode8021Q, "hrui_vlan_8021q", &resp.Diagnostics)
+	if resp.Diagnostics.HasError() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var model vlan8021qModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() || model.VlanID.IsUnknown() || model.UntaggedPorts.IsUnknown() {
+		return
+	}
+
+	// Ports may still be unknown if they refer to other resources.
+	var elements []types.String
+	resp.Diagnostics.Append(model.UntaggedPorts.ElementsAs(ctx, &elements, false)...)
+	untaggedPorts := make([]string, 0, len(elements))
+	for _, port := range elements {
+		if port.IsUnknown() {
+			return
+		}
+		untaggedPorts = append(untaggedPorts, port.ValueString())
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	vlanID := int(model.VlanID.ValueInt64())
+	providerutil.ValidatePVIDs(ctx, r.devices, req.Plan, req.State, "hrui_vlan_8021q", false,
+		func(ctx context.Context, vlans []*sdk.Vlan, ports []*sdk.PortVLANConfig) ([]*sdk.Vlan, []string) {
+			vlans = slices.DeleteFunc(vlans, func(vlan *sdk.Vlan) bool { return vlan.VlanID == vlanID })
+			vlans = append(vlans, &sdk.Vlan{VlanID: vlanID, UntaggedPorts: untaggedPorts})
+
+			var affected []string
+			for _, port := range ports {
+				if port.PVID == vlanID {
+					affected = append(affected, port.PortName)
+				}
+			}
+			return vlans, affected
+		}, &resp.Diagnostics)
 }
 
 func extractStringList(ctx context.Context, list types.List) ([]string, diag.Diagnostics) {
@@ -87,6 +136,12 @@ func extractStringList(ctx context.Context, list types.List) ([]string, diag.Dia
 
 // Create configures the VLAN with the given ID, name, and ports.
 func (r *vlan8021qResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	r.client = r.devices.Client(ctx, req.Plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer providerutil.KeepDevice(ctx, req.Plan, &resp.State, &resp.Diagnostics)
+
 	var model vlan8021qModel
 
 	// Extract the plan
@@ -129,6 +184,12 @@ func (r *vlan8021qResource) Create(ctx context.Context, req resource.CreateReque
 }
 
 func (r *vlan8021qResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	r.client = r.devices.Client(ctx, req.State, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer providerutil.KeepDevice(ctx, req.State, &resp.State, &resp.Diagnostics)
+
 	var state vlan8021qModel
 
 	diags := req.State.Get(ctx, &state)
@@ -140,6 +201,11 @@ func (r *vlan8021qResource) Read(ctx context.Context, req resource.ReadRequest,
 	tflog.Debug(ctx, "Reading VLAN", map[string]any{"vlan_id": state.VlanID.ValueInt64()})
 
 	vlan, err := r.client.GetVLAN(ctx, int(state.VlanID.ValueInt64()))
+	if errors.Is(err, sdk.ErrNotFound) {
+		tflog.Warn(ctx, "VLAN no longer exists on the device, removing from state", map[string]any{"vlan_id": state.VlanID.ValueInt64()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError("Error Reading VLAN", fmt.Sprintf("Could not read VLAN ID %d: %s", state.VlanID.ValueInt64(), err))
 		return
@@ -163,6 +229,12 @@ func (r *vlan8021qResource) Read(ctx context.Context, req resource.ReadRequest,
 }
 
 func (r *vlan8021qResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	r.client = r.devices.Client(ctx, req.Plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer providerutil.KeepDevice(ctx, req.Plan, &resp.State, &resp.Diagnostics)
+
 	var plan vlan8021qModel
 
 	diags := req.Plan.Get(ctx, &plan)
@@ -236,6 +308,11 @@ func (r *vlan8021qResource) Update(ctx context.Context, req resource.UpdateReque
 }
 
 func (r *vlan8021qResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	r.client = r.devices.Client(ctx, req.State, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var state vlan8021qModel
 
 	// Retrieve the current state
@@ -270,6 +347,14 @@ func (r *vlan8021qResource) Delete(ctx context.Context, req resource.DeleteReque
 
 // ImportState imports an existing VLAN resource by numeric VLAN ID.
 func (r *vlan8021qResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	device, importID := providerutil.SplitImportID(req.ID)
+	r.client = r.devices.Get(ctx, device, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer providerutil.SetDevice(ctx, device, &resp.State, &resp.Diagnostics)
+	req.ID = importID
+
 	tflog.Debug(ctx, "Importing VLAN", map[string]any{"id": req.ID})
 
 	id, err := strconv.ParseInt(req.ID, 10, 64)
@@ -0,0 +1,11 @@
+package vlan_mode
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// vlanModeModel represents the state model for the VLAN mode Terraform resource.
+type vlanModeModel struct {
+	Mode   types.String `tfsdk:"mode"`
+	Device types.String `tfsdk:"device"`
+}
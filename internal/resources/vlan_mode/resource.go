@@ -0,0 +1,203 @@
+package vlan_mode
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/brennoo/terraform-provider-hrui/internal/providerutil"
+	"github.com/brennoo/terraform-provider-hrui/internal/sdk"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the required interfaces.
+var (
+	_ resource.Resource                = &vlanModeResource{}
+	_ resource.ResourceWithConfigure   = &vlanModeResource{}
+	_ resource.ResourceWithImportState = &vlanModeResource{}
+)
+
+// vlanModeResource is the implementation of the VLAN mode Terraform resource.
+type vlanModeResource struct {
+	client  *sdk.HRUIClient
+	devices *providerutil.Devices
+}
+
+// NewResource creates a new instance of the VLAN mode resource.
+func NewResource() resource.Resource {
+	return &vlanModeResource{}
+}
+
+// Metadata sets the resource name for Terraform.
+func (r *vlanModeResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_vlan_mode"
+}
+
+// Schema defines the schema for the VLAN mode resource.
+func (r *vlanModeResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Selects the global VLAN mode of the switch.",
+		MarkdownDescription: "Selects the global VLAN mode of the switch: 802.1Q VLANs (`hrui_vlan_8021q`, `hrui_vlan_vid`) or port-based VLAN groups (`hrui_vlan_port_based`). " +
+			"The switch may reset the VLAN configuration of the previous mode when the mode changes. " +
+			"Requires firmware with a VLAN mode selector. Firmware 1.9 and 1.9.1 are not known to have one, so on them the resource reports an unsupported feature error.",
+		Attributes: map[string]schema.Attribute{
+			"device": providerutil.DeviceResourceAttribute(),
+			"mode": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The VLAN mode. Valid values are `802.1q` and `port_based`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(string(sdk.VLANMode8021Q), string(sdk.VLANModePortBased)),
+				},
+			},
+		},
+	}
+}
+
+// Configure assigns the provider-configured client to the resource.
+func (r *vlanModeResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.devices = providerutil.ConfigureDevices(req.ProviderData, &resp.Diagnostics)
+}
+
+// Create sets the VLAN mode from the plan.
+func (r *vlanModeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	r.client = r.devices.Client(ctx, req.Plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer providerutil.KeepDevice(ctx, req.Plan, &resp.State, &resp.Diagnostics)
+
+	var plan vlanModeModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating VLAN mode", map[string]any{"mode": plan.Mode.ValueString()})
+
+	if err := r.client.SetVLANMode(ctx, sdk.VLANMode(plan.Mode.ValueString())); err != nil {
+		if providerutil.AddUnsupportedFeatureError(&resp.Diagnostics, err) {
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Creating VLAN Mode",
+			fmt.Sprintf("Failed to set VLAN mode: %s", err),
+		)
+		return
+	}
+
+	plan.Mode = r.readBack(ctx, "Error Creating VLAN Mode", &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read retrieves the VLAN mode from the device and updates the state.
+func (r *vlanModeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	r.client = r.devices.Client(ctx, req.State, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer providerutil.KeepDevice(ctx, req.State, &resp.State, &resp.Diagnostics)
+
+	mode, err := r.client.GetVLANMode(ctx)
+	if err != nil {
+		if providerutil.AddUnsupportedFeatureError(&resp.Diagnostics, err) {
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading VLAN Mode",
+			fmt.Sprintf("Failed to read VLAN mode: %s", err),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &vlanModeModel{
+		Mode: types.StringValue(string(mode)),
+	})...)
+}
+
+// Update sets the changed VLAN mode.
+func (r *vlanModeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	r.client = r.devices.Client(ctx, req.Plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer providerutil.KeepDevice(ctx, req.Plan, &resp.State, &resp.Diagnostics)
+
+	var plan vlanModeModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Updating VLAN mode", map[string]any{"mode": plan.Mode.ValueString()})
+
+	if err := r.client.SetVLANMode(ctx, sdk.VLANMode(plan.Mode.ValueString())); err != nil {
+		if providerutil.AddUnsupportedFeatureError(&resp.Diagnostics, err) {
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Updating VLAN Mode",
+			fmt.Sprintf("Failed to set VLAN mode: %s", err),
+		)
+		return
+	}
+
+	plan.Mode = r.readBack(ctx, "Error Updating VLAN Mode", &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// readBack reads the VLAN mode after it was set. On unknown firmware without the mode selector,
+// the missing selector is only noticed when reading the page.
+func (r *vlanModeResource) readBack(ctx context.Context, summary string, diags *diag.Diagnostics) types.String {
+	mode, err := r.client.GetVLANMode(ctx)
+	if err != nil {
+		if !providerutil.AddUnsupportedFeatureError(diags, err) {
+			diags.AddError(summary, fmt.Sprintf("Failed to read back VLAN mode: %s", err))
+		}
+		return types.StringNull()
+	}
+	return types.StringValue(string(mode))
+}
+
+// Delete removes the resource from the state. The switch always has a VLAN mode, and switching
+// back could reset its VLANs, so the mode is left as it is.
+func (r *vlanModeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Deleting VLAN mode; leaving the device VLAN mode unchanged")
+}
+
+// ImportState imports the current VLAN mode of the device.
+func (r *vlanModeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	device, importID := providerutil.SplitImportID(req.ID)
+	r.client = r.devices.Get(ctx, device, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer providerutil.SetDevice(ctx, device, &resp.State, &resp.Diagnostics)
+	req.ID = importID
+
+	tflog.Debug(ctx, "Importing VLAN mode", map[string]any{"id": req.ID})
+
+	mode, err := r.client.GetVLANMode(ctx)
+	if err != nil {
+		if providerutil.AddUnsupportedFeatureError(&resp.Diagnostics, err) {
+			return
+		}
+		resp.Diagnostics.AddError("Error Importing VLAN Mode", fmt.Sprintf("Unable to import VLAN mode: %s", err))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &vlanModeModel{
+		Mode: types.StringValue(string(mode)),
+	})...)
+}
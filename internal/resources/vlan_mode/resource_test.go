@@ -0,0 +1,40 @@
+package vlan_mode_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	// Import the test helper package.
+	"github.com/brennoo/terraform-provider-hrui/internal/provider"
+)
+
+// TestAccVlanModeResource provides an acceptance test for the hrui_vlan_mode
+// resource. No recorded firmware has the VLAN mode selector, so creating the
+// resource reports an unsupported feature without sending the form.
+func TestAccVlanModeResource(t *testing.T) {
+	providerFactories := provider.TestAccProtoV6ProviderFactories(t, "vlan_mode_resource_test")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			// Step 1: Selecting the VLAN mode fails before anything is posted
+			{
+				Config:      testAccVlanModeResourceConfig(),
+				ExpectError: regexp.MustCompile(`Unsupported Feature`),
+			},
+		},
+	})
+}
+
+// testAccVlanModeResourceConfig generates the HCL for the hrui_vlan_mode resource.
+func testAccVlanModeResourceConfig() string {
+	return `
+provider "hrui" {}
+
+resource "hrui_vlan_mode" "test" {
+  mode = "802.1q"
+}
+`
+}
@@ -0,0 +1,12 @@
+package vlan_port_based
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// vlanPortBasedModel represents the state model for the port-based VLAN Terraform resource.
+type vlanPortBasedModel struct {
+	VlanID      types.Int64  `tfsdk:"vlan_id"`
+	MemberPorts types.List   `tfsdk:"member_ports"`
+	Device      types.String `tfsdk:"device"`
+}
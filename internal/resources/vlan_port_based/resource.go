@@ -0,0 +1,257 @@
+package vlan_port_based
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+
+	"github.com/brennoo/terraform-provider-hrui/internal/providerutil"
+	"github.com/brennoo/terraform-provider-hrui/internal/sdk"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the required interfaces.
+var (
+	_ resource.Resource                = &vlanPortBasedResource{}
+	_ resource.ResourceWithConfigure   = &vlanPortBasedResource{}
+	_ resource.ResourceWithImportState = &vlanPortBasedResource{}
+	_ resource.ResourceWithModifyPlan  = &vlanPortBasedResource{}
+)
+
+// vlanPortBasedResource is the implementation of the port-based VLAN Terraform resource.
+type vlanPortBasedResource struct {
+	client  *sdk.HRUIClient
+	devices *providerutil.Devices
+}
+
+// NewResource creates a new instance of the port-based VLAN resource.
+func NewResource() resource.Resource {
+	return &vlanPortBasedResource{}
+}
+
+// Metadata sets the resource name for Terraform.
+func (r *vlanPortBasedResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_vlan_port_based"
+}
+
+// Schema defines the schema for the port-based VLAN resource.
+func (r *vlanPortBasedResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Configures a port-based VLAN group.",
+		MarkdownDescription: "Configures a port-based VLAN group: ports of the group can only exchange frames with each other. " +
+			"Requires the switch to be in `port_based` VLAN mode, see `hrui_vlan_mode`. Like `hrui_vlan_mode`, the resource reports an unsupported feature error on firmware 1.9 and 1.9.1.",
+		Attributes: map[string]schema.Attribute{
+			"device": providerutil.DeviceResourceAttribute(),
+			"vlan_id": schema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the port-based VLAN group.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"member_ports": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Required:            true,
+				MarkdownDescription: "The ports in the group (e.g., 'Port 1', 'Trunk1').",
+			},
+		},
+	}
+}
+
+// Configure assigns the provider-configured client to the resource.
+func (r *vlanPortBasedResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.devices = providerutil.ConfigureDevices(req.ProviderData, &resp.Diagnostics)
+}
+
+// ModifyPlan fails the plan if the switch is not in port-based VLAN mode.
+func (r *vlanPortBasedResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	providerutil.RequireVLANMode(ctx, r.devices, req.Plan, req.State, sdk.VLANModePortBased, "hrui_vlan_port_based", &resp.Diagnostics)
+}
+
+// Create creates the port-based VLAN group.
+func (r *vlanPortBasedResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	r.client = r.devices.Client(ctx, req.Plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer providerutil.KeepDevice(ctx, req.Plan, &resp.State, &resp.Diagnostics)
+
+	var plan vlanPortBasedModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating port-based VLAN", map[string]any{"vlan_id": plan.VlanID.ValueInt64()})
+
+	if err := r.set(ctx, &plan); err != nil {
+		if providerutil.AddUnsupportedFeatureError(&resp.Diagnostics, err) {
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Creating Port-Based VLAN",
+			fmt.Sprintf("Failed to create port-based VLAN %d: %s", plan.VlanID.ValueInt64(), err),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read retrieves the port-based VLAN group from the device and updates the state.
+func (r *vlanPortBasedResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	r.client = r.devices.Client(ctx, req.State, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer providerutil.KeepDevice(ctx, req.State, &resp.State, &resp.Diagnostics)
+
+	var state vlanPortBasedModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	vlan, err := r.client.GetPortBasedVLAN(ctx, int(state.VlanID.ValueInt64()))
+	if errors.Is(err, sdk.ErrNotFound) {
+		tflog.Warn(ctx, "Port-based VLAN no longer exists on the device, removing from state", map[string]any{"vlan_id": state.VlanID.ValueInt64()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		if providerutil.AddUnsupportedFeatureError(&resp.Diagnostics, err) {
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading Port-Based VLAN",
+			fmt.Sprintf("Could not read port-based VLAN %d: %s", state.VlanID.ValueInt64(), err),
+		)
+		return
+	}
+
+	// Keep the configured order if the device reports the same ports. The list is null after an import.
+	var current []string
+	if !state.MemberPorts.IsNull() {
+		resp.Diagnostics.Append(state.MemberPorts.ElementsAs(ctx, &current, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	if state.MemberPorts.IsNull() || !sameMembers(current, vlan.MemberPorts) {
+		members, diags := types.ListValueFrom(ctx, types.StringType, vlan.MemberPorts)
+		resp.Diagnostics.Append(diags...)
+		state.MemberPorts = members
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update applies the changed member ports.
+func (r *vlanPortBasedResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	r.client = r.devices.Client(ctx, req.Plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer providerutil.KeepDevice(ctx, req.Plan, &resp.State, &resp.Diagnostics)
+
+	var plan vlanPortBasedModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Updating port-based VLAN", map[string]any{"vlan_id": plan.VlanID.ValueInt64()})
+
+	if err := r.set(ctx, &plan); err != nil {
+		if providerutil.AddUnsupportedFeatureError(&resp.Diagnostics, err) {
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Updating Port-Based VLAN",
+			fmt.Sprintf("Failed to update port-based VLAN %d: %s", plan.VlanID.ValueInt64(), err),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete removes the port-based VLAN group from the device.
+func (r *vlanPortBasedResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	r.client = r.devices.Client(ctx, req.State, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state vlanPortBasedModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Deleting port-based VLAN", map[string]any{"vlan_id": state.VlanID.ValueInt64()})
+
+	if err := r.client.RemovePortBasedVLAN(ctx, int(state.VlanID.ValueInt64())); err != nil {
+		if providerutil.AddUnsupportedFeatureError(&resp.Diagnostics, err) {
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Deleting Port-Based VLAN",
+			fmt.Sprintf("Failed to delete port-based VLAN %d: %s", state.VlanID.ValueInt64(), err),
+		)
+	}
+}
+
+// ImportState imports an existing port-based VLAN group by its numeric ID.
+func (r *vlanPortBasedResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	device, importID := providerutil.SplitImportID(req.ID)
+	r.client = r.devices.Get(ctx, device, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer providerutil.SetDevice(ctx, device, &resp.State, &resp.Diagnostics)
+	req.ID = importID
+
+	tflog.Debug(ctx, "Importing port-based VLAN", map[string]any{"id": req.ID})
+
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Importing Port-Based VLAN", fmt.Sprintf("Expected a numeric VLAN ID, got %q: %s", req.ID, err))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("vlan_id"), types.Int64Value(id))...)
+}
+
+// set writes the port-based VLAN group of plan to the device.
+func (r *vlanPortBasedResource) set(ctx context.Context, plan *vlanPortBasedModel) error {
+	var members []string
+	if diags := plan.MemberPorts.ElementsAs(ctx, &members, false); diags.HasError() {
+		return fmt.Errorf("invalid member_ports")
+	}
+
+	return r.client.SetPortBasedVLAN(ctx, &sdk.PortBasedVLAN{
+		ID:          int(plan.VlanID.ValueInt64()),
+		MemberPorts: members,
+	})
+}
+
+// sameMembers reports whether a and b contain the same ports, in any order.
+func sameMembers(a, b []string) bool {
+	a, b = slices.Clone(a), slices.Clone(b)
+	slices.Sort(a)
+	slices.Sort(b)
+	return slices.Equal(a, b)
+}
@@ -0,0 +1,43 @@
+package vlan_port_based_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	// Import the test helper package.
+	"github.com/brennoo/terraform-provider-hrui/internal/provider"
+)
+
+// TestAccVlanPortBasedResource provides an acceptance test for the
+// hrui_vlan_port_based resource. No recorded firmware has the port-based
+// VLAN pages, so creating the resource reports an unsupported feature
+// without sending the form.
+func TestAccVlanPortBasedResource(t *testing.T) {
+	providerFactories := provider.TestAccProtoV6ProviderFactories(t, "vlan_port_based_resource_test")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			// Step 1: Creating the group fails before anything is posted
+			{
+				Config:      testAccVlanPortBasedResourceConfig(),
+				ExpectError: regexp.MustCompile(`Unsupported Feature`),
+			},
+		},
+	})
+}
+
+// testAccVlanPortBasedResourceConfig generates the HCL for the
+// hrui_vlan_port_based resource.
+func testAccVlanPortBasedResourceConfig() string {
+	return `
+provider "hrui" {}
+
+resource "hrui_vlan_port_based" "test" {
+  vlan_id      = 2
+  member_ports = ["Port 1", "Port 2"]
+}
+`
+}
@@ -0,0 +1,29 @@
+package vlan_table
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// vlanTableModel represents the complete VLAN configuration of the switch.
+type vlanTableModel struct {
+	VLANs  types.Set    `tfsdk:"vlans"`
+	PVIDs  types.Map    `tfsdk:"pvids"`
+	Device types.String `tfsdk:"device"`
+}
+
+// vlanModel represents one VLAN of the table.
+type vlanModel struct {
+	VlanID        types.Int64  `tfsdk:"vlan_id"`
+	Name          types.String `tfsdk:"name"`
+	UntaggedPorts types.Set    `tfsdk:"untagged_ports"`
+	TaggedPorts   types.Set    `tfsdk:"tagged_ports"`
+}
+
+// vlanAttrTypes are the attribute types of a vlanModel object.
+var vlanAttrTypes = map[string]attr.Type{
+	"vlan_id":        types.Int64Type,
+	"name":           types.StringType,
+	"untagged_ports": types.SetType{ElemType: types.StringType},
+	"tagged_ports":   types.SetType{ElemType: types.StringType},
+}
@@ -0,0 +1,382 @@
+package vlan_table
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/brennoo/terraform-provider-hrui/internal/providerutil"
+	"github.com/brennoo/terraform-provider-hrui/internal/sdk"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the required interfaces.
+var (
+	_ resource.Resource                = &vlanTableResource{}
+	_ resource.ResourceWithConfigure   = &vlanTableResource{}
+	_ resource.ResourceWithImportState = &vlanTableResource{}
+	_ resource.ResourceWithModifyPlan  = &vlanTableResource{}
+)
+
+// vlanTableResource is the implementation of the authoritative VLAN table Terraform resource.
+type vlanTableResource struct {
+	client  *sdk.HRUIClient
+	devices *providerutil.Devices
+}
+
+// NewResource creates a new instance of the VLAN table resource.
+func NewResource() resource.Resource {
+	return &vlanTableResource{}
+}
+
+// Metadata sets the resource name for Terraform.
+func (r *vlanTableResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_vlan_table"
+}
+
+// Schema defines the schema for the VLAN table resource.
+func (r *vlanTableResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the complete 802.1Q VLAN table of the switch.",
+		MarkdownDescription: "Manages the complete 802.1Q VLAN table of the switch. The resource is authoritative: " +
+			"VLANs that are not listed in `vlans` are deleted, except the default VLAN 1, and when `pvids` is set, " +
+			"ports that are not listed are reset to PVID 1. Do not combine it with `hrui_vlan_8021q` or `hrui_vlan_vid` on the same switch.",
+		Attributes: map[string]schema.Attribute{
+			"device": providerutil.DeviceResourceAttribute(),
+			"vlans": schema.SetNestedAttribute{
+				Required: true,
+				MarkdownDescription: "The VLANs of the switch. VLAN 1 cannot be deleted; list it to manage its name and members, " +
+					"or leave it out to keep it unmanaged.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"vlan_id": schema.Int64Attribute{
+							Required:            true,
+							MarkdownDescription: "VLAN ID (1-4094).",
+							Validators: []validator.Int64{
+								int64validator.Between(1, 4094),
+							},
+						},
+						"name": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "The VLAN name.",
+						},
+						"untagged_ports": schema.SetAttribute{
+							ElementType:         types.StringType,
+							Required:            true,
+							MarkdownDescription: "The untagged member ports of the VLAN (e.g., 'Port 1', 'Trunk1').",
+						},
+						"tagged_ports": schema.SetAttribute{
+							ElementType:         types.StringType,
+							Required:            true,
+							MarkdownDescription: "The tagged member ports of the VLAN.",
+						},
+					},
+				},
+			},
+			"pvids": schema.MapAttribute{
+				ElementType: types.Int64Type,
+				Optional:    true,
+				MarkdownDescription: "The PVID of each port, keyed by port name (e.g., 'Port 1'). Ports that are not listed are reset to PVID 1. " +
+					"If omitted, the PVIDs are left unmanaged.",
+			},
+		},
+	}
+}
+
+// Configure assigns the provider-configured client to the resource.
+func (r *vlanTableResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.devices = providerutil.ConfigureDevices(req.ProviderData, &resp.Diagnostics)
+}
+
+// ModifyPlan fails the plan if the switch is not in 802.1Q VLAN mode, and warns about ports whose
+// PVID will not be a VLAN they are an untagged member of.
+func (r *vlanTableResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	providerutil.RequireVLANMode(ctx, r.devices, req.Plan, req.State, sdk.VLANMode8021Q, "hrui_vlan_table", &resp.Diagnostics)
+	if resp.Diagnostics.HasError() || req.Plan.Raw.IsNull() || !req.Plan.Raw.IsFullyKnown() {
+		return
+	}
+
+	var plan vlanTableModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	table := desiredTable(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The table is authoritative, so every port is checked against the VLANs it leaves on the switch.
+	providerutil.ValidatePVIDs(ctx, r.devices, req.Plan, req.State, "hrui_vlan_table", true,
+		func(ctx context.Context, vlans []*sdk.Vlan, ports []*sdk.PortVLANConfig) ([]*sdk.Vlan, []string) {
+			return projectTable(table, vlans, ports)
+		}, &resp.Diagnostics)
+}
+
+// projectTable returns the VLANs the device will have once table is applied, and sets the PVIDs
+// of ports to the ones table gives them. VLAN 1 is kept if table does not manage it. Ports missing
+// from the PVIDs of table are reset to VLAN 1; a nil PVID map leaves all ports unchanged.
+// It returns the names of all ports, which are checked.
+func projectTable(table *sdk.VLANTable, vlans []*sdk.Vlan, ports []*sdk.PortVLANConfig) ([]*sdk.Vlan, []string) {
+	desired := slices.Clone(table.VLANs)
+	if !slices.ContainsFunc(desired, func(vlan *sdk.Vlan) bool { return vlan.VlanID == sdk.DefaultVLANID }) {
+		for _, vlan := range vlans {
+			if vlan.VlanID == sdk.DefaultVLANID {
+				desired = append(desired, vlan)
+			}
+		}
+	}
+
+	names := make([]string, 0, len(ports))
+	for _, port := range ports {
+		if table.PVIDs != nil {
+			port.PVID = sdk.DefaultVLANID
+			if pvid, ok := table.PVIDs[port.PortName]; ok {
+				port.PVID = pvid
+			}
+		}
+		names = append(names, port.PortName)
+	}
+	return desired, names
+}
+
+// Create applies the VLAN table from the plan.
+func (r *vlanTableResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	r.client = r.devices.Client(ctx, req.Plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer providerutil.KeepDevice(ctx, req.Plan, &resp.State, &resp.Diagnostics)
+
+	var plan vlanTableModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating VLAN table")
+
+	if err := r.apply(ctx, &plan, &resp.Diagnostics); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating VLAN Table",
+			fmt.Sprintf("Failed to apply VLAN table: %s", err),
+		)
+		return
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read retrieves the VLAN table from the device and updates the state.
+func (r *vlanTableResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	r.client = r.devices.Client(ctx, req.State, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer providerutil.KeepDevice(ctx, req.State, &resp.State, &resp.Diagnostics)
+
+	var state vlanTableModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	table, err := r.client.GetVLANTable(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading VLAN Table",
+			fmt.Sprintf("Could not read VLAN table: %s", err),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(refreshState(ctx, table, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update applies the changed VLAN table.
+func (r *vlanTableResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	r.client = r.devices.Client(ctx, req.Plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer providerutil.KeepDevice(ctx, req.Plan, &resp.State, &resp.Diagnostics)
+
+	var plan vlanTableModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Updating VLAN table")
+
+	if err := r.apply(ctx, &plan, &resp.Diagnostics); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating VLAN Table",
+			fmt.Sprintf("Failed to apply VLAN table: %s", err),
+		)
+		return
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete removes the resource from the state. The VLANs are left on the switch: deleting them
+// could cut off the management connection, and the switch cannot run without a VLAN table.
+func (r *vlanTableResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Deleting VLAN table; leaving the device VLANs unchanged")
+}
+
+// ImportState imports the current VLAN table of the device. VLAN 1 is left unmanaged and the
+// PVIDs other than 1 are imported.
+func (r *vlanTableResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	device, importID := providerutil.SplitImportID(req.ID)
+	r.client = r.devices.Get(ctx, device, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer providerutil.SetDevice(ctx, device, &resp.State, &resp.Diagnostics)
+	req.ID = importID
+
+	tflog.Debug(ctx, "Importing VLAN table", map[string]any{"id": req.ID})
+
+	table, err := r.client.GetVLANTable(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Importing VLAN Table", fmt.Sprintf("Unable to import VLAN table: %s", err))
+		return
+	}
+
+	state := vlanTableModel{
+		VLANs: types.SetValueMust(types.ObjectType{AttrTypes: vlanAttrTypes}, nil),
+		PVIDs: types.MapValueMust(types.Int64Type, nil),
+	}
+	resp.Diagnostics.Append(refreshState(ctx, table, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// apply writes the VLAN table of plan to the device.
+func (r *vlanTableResource) apply(ctx context.Context, plan *vlanTableModel, diags *diag.Diagnostics) error {
+	table := desiredTable(ctx, plan, diags)
+	if diags.HasError() {
+		return nil
+	}
+
+	changes, err := r.client.ApplyVLANTable(ctx, table)
+	if err != nil {
+		return err
+	}
+	if changes.Empty() {
+		tflog.Debug(ctx, "VLAN table already up to date")
+	}
+	return nil
+}
+
+// desiredTable converts plan to the VLAN table it describes.
+func desiredTable(ctx context.Context, plan *vlanTableModel, diags *diag.Diagnostics) *sdk.VLANTable {
+	var vlans []vlanModel
+	diags.Append(plan.VLANs.ElementsAs(ctx, &vlans, false)...)
+
+	table := &sdk.VLANTable{}
+	for _, vlan := range vlans {
+		v := &sdk.Vlan{VlanID: int(vlan.VlanID.ValueInt64()), Name: vlan.Name.ValueString()}
+		diags.Append(vlan.UntaggedPorts.ElementsAs(ctx, &v.UntaggedPorts, false)...)
+		diags.Append(vlan.TaggedPorts.ElementsAs(ctx, &v.TaggedPorts, false)...)
+		table.VLANs = append(table.VLANs, v)
+	}
+
+	if !plan.PVIDs.IsNull() {
+		var pvids map[string]int64
+		diags.Append(plan.PVIDs.ElementsAs(ctx, &pvids, false)...)
+
+		table.PVIDs = make(map[string]int, len(pvids))
+		for port, pvid := range pvids {
+			table.PVIDs[port] = int(pvid)
+		}
+	}
+	return table
+}
+
+// refreshState replaces the VLANs and PVIDs of state with table. VLAN 1 is only kept if state
+// already manages it, and the PVIDs only if state manages them: then the listed ports and all
+// ports with a PVID other than 1 are kept, so that changes on the device show up as drift.
+func refreshState(ctx context.Context, table *sdk.VLANTable, state *vlanTableModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var prior []vlanModel
+	diags.Append(state.VLANs.ElementsAs(ctx, &prior, false)...)
+	managesDefault := false
+	for _, vlan := range prior {
+		if vlan.VlanID.ValueInt64() == sdk.DefaultVLANID {
+			managesDefault = true
+		}
+	}
+
+	vlans := make([]vlanModel, 0, len(table.VLANs))
+	for _, vlan := range table.VLANs {
+		if vlan.VlanID == sdk.DefaultVLANID && !managesDefault {
+			continue
+		}
+
+		untagged, d := types.SetValueFrom(ctx, types.StringType, nonNil(vlan.UntaggedPorts))
+		diags.Append(d...)
+		tagged, d := types.SetValueFrom(ctx, types.StringType, nonNil(vlan.TaggedPorts))
+		diags.Append(d...)
+
+		vlans = append(vlans, vlanModel{
+			VlanID:        types.Int64Value(int64(vlan.VlanID)),
+			Name:          types.StringValue(vlan.Name),
+			UntaggedPorts: untagged,
+			TaggedPorts:   tagged,
+		})
+	}
+
+	set, d := types.SetValueFrom(ctx, types.ObjectType{AttrTypes: vlanAttrTypes}, vlans)
+	diags.Append(d...)
+	state.VLANs = set
+
+	if !state.PVIDs.IsNull() {
+		var listed map[string]int64
+		diags.Append(state.PVIDs.ElementsAs(ctx, &listed, false)...)
+
+		pvids := make(map[string]int64)
+		for port, pvid := range table.PVIDs {
+			if _, ok := listed[port]; ok || pvid != sdk.DefaultVLANID {
+				pvids[port] = int64(pvid)
+			}
+		}
+
+		m, d := types.MapValueFrom(ctx, types.Int64Type, pvids)
+		diags.Append(d...)
+		state.PVIDs = m
+	}
+
+	return diags
+}
+
+// nonNil returns ports, or an empty slice if it is nil, so that it converts to an empty set.
+func nonNil(ports []string) []string {
+	if ports == nil {
+		return []string{}
+	}
+	return ports
+}
@@ -0,0 +1,200 @@
+package vlan_table
+
+import (
+	"context"
+	"testing"
+
+	"github.com/brennoo/terraform-provider-hrui/internal/sdk"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testVLAN builds the object of one VLAN of the vlans attribute.
+func testVLAN(t *testing.T, id int64, name string, untagged, tagged []string) vlanModel {
+	t.Helper()
+	untaggedSet, d := types.SetValueFrom(context.Background(), types.StringType, untagged)
+	require.False(t, d.HasError(), d)
+	taggedSet, d := types.SetValueFrom(context.Background(), types.StringType, tagged)
+	require.False(t, d.HasError(), d)
+	return vlanModel{VlanID: types.Int64Value(id), Name: types.StringValue(name), UntaggedPorts: untaggedSet, TaggedPorts: taggedSet}
+}
+
+// testModel builds a vlanTableModel. A nil pvids map makes the pvids attribute null.
+func testModel(t *testing.T, vlans []vlanModel, pvids map[string]int64) *vlanTableModel {
+	t.Helper()
+	vlanSet, d := types.SetValueFrom(context.Background(), types.ObjectType{AttrTypes: vlanAttrTypes}, vlans)
+	require.False(t, d.HasError(), d)
+
+	model := &vlanTableModel{VLANs: vlanSet, PVIDs: types.MapNull(types.Int64Type)}
+	if pvids != nil {
+		pvidMap, d := types.MapValueFrom(context.Background(), types.Int64Type, pvids)
+		require.False(t, d.HasError(), d)
+		model.PVIDs = pvidMap
+	}
+	return model
+}
+
+func TestDesiredTable(t *testing.T) {
+	vlans := []vlanModel{testVLAN(t, 10, "users", []string{"Port 1"}, []string{"Port 8"})}
+
+	tests := []struct {
+		name     string
+		pvids    map[string]int64
+		expected map[string]int
+	}{
+		{"Null pvids leave the PVIDs unmanaged", nil, nil},
+		{"Empty pvids reset every port", map[string]int64{}, map[string]int{}},
+		{"Listed pvids", map[string]int64{"Port 1": 10}, map[string]int{"Port 1": 10}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			table := desiredTable(context.Background(), testModel(t, vlans, tt.pvids), &diags)
+			require.False(t, diags.HasError(), diags)
+
+			assert.Equal(t, []*sdk.Vlan{{VlanID: 10, Name: "users", UntaggedPorts: []string{"Port 1"}, TaggedPorts: []string{"Port 8"}}}, table.VLANs)
+			assert.Equal(t, tt.expected, table.PVIDs)
+			if tt.pvids != nil {
+				assert.NotNil(t, table.PVIDs, "an empty pvids map must not become nil")
+			}
+		})
+	}
+}
+
+func TestRefreshState(t *testing.T) {
+	table := &sdk.VLANTable{
+		VLANs: []*sdk.Vlan{
+			{VlanID: 1, Name: "default", UntaggedPorts: []string{"Port 2", "Port 3"}},
+			{VlanID: 10, Name: "users", UntaggedPorts: []string{"Port 1"}},
+		},
+		PVIDs: map[string]int{"Port 1": 10, "Port 2": 1, "Port 3": 1, "Port 4": 20},
+	}
+
+	tests := []struct {
+		name          string
+		state         *vlanTableModel
+		expectedVLANs []int64
+		expectedPVIDs map[string]int64
+	}{
+		{
+			"VLAN 1 unmanaged, pvids null",
+			testModel(t, []vlanModel{testVLAN(t, 10, "users", []string{"Port 1"}, nil)}, nil),
+			[]int64{10},
+			nil,
+		},
+		{
+			"VLAN 1 managed",
+			testModel(t, []vlanModel{testVLAN(t, 1, "default", nil, nil)}, nil),
+			[]int64{1, 10},
+			nil,
+		},
+		{
+			// Ports with a PVID other than 1 show up as drift, ports left on VLAN 1 do not.
+			"Empty pvids",
+			testModel(t, nil, map[string]int64{}),
+			[]int64{10},
+			map[string]int64{"Port 1": 10, "Port 4": 20},
+		},
+		{
+			"Listed ports are kept on VLAN 1",
+			testModel(t, nil, map[string]int64{"Port 2": 1}),
+			[]int64{10},
+			map[string]int64{"Port 1": 10, "Port 2": 1, "Port 4": 20},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			state := tt.state
+			diags := refreshState(context.Background(), table, state)
+			require.False(t, diags.HasError(), diags)
+
+			var vlans []vlanModel
+			require.False(t, state.VLANs.ElementsAs(context.Background(), &vlans, false).HasError())
+			ids := make([]int64, 0, len(vlans))
+			for _, vlan := range vlans {
+				ids = append(ids, vlan.VlanID.ValueInt64())
+				assert.False(t, vlan.TaggedPorts.IsNull(), "ports without members must be an empty set")
+			}
+			assert.ElementsMatch(t, tt.expectedVLANs, ids)
+
+			if tt.expectedPVIDs == nil {
+				assert.True(t, state.PVIDs.IsNull())
+				return
+			}
+			var pvids map[string]int64
+			require.False(t, state.PVIDs.ElementsAs(context.Background(), &pvids, false).HasError())
+			assert.Equal(t, tt.expectedPVIDs, pvids)
+		})
+	}
+}
+
+func TestProjectTable(t *testing.T) {
+	deviceVLANs := []*sdk.Vlan{
+		{VlanID: 1, Name: "default", UntaggedPorts: []string{"Port 1", "Port 2", "Port 3"}},
+		{VlanID: 30, Name: "old"},
+	}
+	devicePorts := func() []*sdk.PortVLANConfig {
+		return []*sdk.PortVLANConfig{
+			{PortID: 1, PortName: "Port 1", PVID: 30},
+			{PortID: 2, PortName: "Port 2", PVID: 30},
+			{PortID: 3, PortName: "Port 3", PVID: 1},
+		}
+	}
+	users := &sdk.Vlan{VlanID: 10, Name: "users", UntaggedPorts: []string{"Port 1"}}
+
+	tests := []struct {
+		name          string
+		table         *sdk.VLANTable
+		expectedVLANs []int
+		expectedPVIDs []int
+	}{
+		{
+			"VLAN 1 unmanaged is kept",
+			&sdk.VLANTable{VLANs: []*sdk.Vlan{users}},
+			[]int{10, 1},
+			[]int{30, 30, 1},
+		},
+		{
+			"VLAN 1 managed",
+			&sdk.VLANTable{VLANs: []*sdk.Vlan{users, {VlanID: 1, Name: "default"}}},
+			[]int{10, 1},
+			[]int{30, 30, 1},
+		},
+		{
+			"Empty pvids reset every port",
+			&sdk.VLANTable{VLANs: []*sdk.Vlan{users}, PVIDs: map[string]int{}},
+			[]int{10, 1},
+			[]int{1, 1, 1},
+		},
+		{
+			"Ports missing from pvids are reset",
+			&sdk.VLANTable{VLANs: []*sdk.Vlan{users}, PVIDs: map[string]int{"Port 1": 10}},
+			[]int{10, 1},
+			[]int{10, 1, 1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ports := devicePorts()
+			vlans, names := projectTable(tt.table, deviceVLANs, ports)
+
+			ids := make([]int, 0, len(vlans))
+			for _, vlan := range vlans {
+				ids = append(ids, vlan.VlanID)
+			}
+			assert.Equal(t, tt.expectedVLANs, ids)
+
+			pvids := make([]int, 0, len(ports))
+			for _, port := range ports {
+				pvids = append(pvids, port.PVID)
+			}
+			assert.Equal(t, tt.expectedPVIDs, pvids)
+			assert.Equal(t, []string{"Port 1", "Port 2", "Port 3"}, names)
+		})
+	}
+}
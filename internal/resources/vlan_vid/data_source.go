@@ -13,7 +13,8 @@ import (
 
 // vlanVIDDataSource defines the VLAN data source.
 type vlanVIDDataSource struct {
-	client *sdk.HRUIClient
+	client  *sdk.HRUIClient
+	devices *providerutil.Devices
 }
 
 // Ensure that vlanVIDDataSource implements the datasource.DataSource interface.
@@ -34,6 +35,7 @@ func (d *vlanVIDDataSource) Schema(ctx context.Context, req datasource.SchemaReq
 	resp.Schema = schema.Schema{
 		Description: "Data source for retrieving VLAN ID settings.",
 		Attributes: map[string]schema.Attribute{
+			"device": providerutil.DeviceDataSourceAttribute(),
 			"port": schema.StringAttribute{
 				Required:            true,
 				MarkdownDescription: "The name of the port (e.g., 'Port 1', 'Trunk2') used to query the VLAN configuration.",
@@ -52,11 +54,17 @@ func (d *vlanVIDDataSource) Schema(ctx context.Context, req datasource.SchemaReq
 
 // Configure initializes the data source with the provided client from the provider.
 func (d *vlanVIDDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
-	d.client = providerutil.ConfigureClient(req.ProviderData, &resp.Diagnostics)
+	d.devices = providerutil.ConfigureDevices(req.ProviderData, &resp.Diagnostics)
 }
 
 // Read queries the VLAN configuration for the specified port.
 func (d *vlanVIDDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	d.client = d.devices.Client(ctx, req.Config, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer providerutil.KeepDevice(ctx, req.Config, &resp.State, &resp.Diagnostics)
+
 	var model vlanVIDModel
 
 	// Retrieve the port name from the user request.
@@ -9,4 +9,5 @@ type vlanVIDModel struct {
 	Port            types.String `tfsdk:"port"`
 	VlanID          types.Int64  `tfsdk:"vlan_id"`
 	AcceptFrameType types.String `tfsdk:"accept_frame_type"`
+	Device          types.String `tfsdk:"device"`
 }
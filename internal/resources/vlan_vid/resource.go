@@ -20,11 +20,13 @@ var (
 	_ resource.Resource                = &vlanVIDResource{}
 	_ resource.ResourceWithConfigure   = &vlanVIDResource{}
 	_ resource.ResourceWithImportState = &vlanVIDResource{}
+	_ resource.ResourceWithModifyPlan  = &vlanVIDResource{}
 )
 
 // vlanVIDResource defines the resource implementation.
 type vlanVIDResource struct {
-	client *sdk.HRUIClient
+	client  *sdk.HRUIClient
+	devices *providerutil.Devices
 }
 
 // NewResource is a helper function to simplify the provider implementation.
@@ -42,6 +44,7 @@ func (r *vlanVIDResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 	resp.Schema = schema.Schema{
 		Description: "Configures VLAN ID settings.",
 		Attributes: map[string]schema.Attribute{
+			"device": providerutil.DeviceResourceAttribute(),
 			"port": schema.StringAttribute{
 				Required:    true,
 				Description: "The name of the port (e.g., 'Port 1', 'Trunk2').",
@@ -63,7 +66,33 @@ func (r *vlanVIDResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 
 // Configure adds the provider configured client to the resource.
 func (r *vlanVIDResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	r.client = providerutil.ConfigureClient(req.ProviderData, &resp.Diagnostics)
+	r.devices = providerutil.ConfigureDevices(req.ProviderData, &resp.Diagnostics)
+}
+
+// ModifyPlan fails the plan if the switch is not in 802.1Q VLAN mode, and warns if the port is
+// not an untagged member of the VLAN its PVID is set to.
+func (r *vlanVIDResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	providerutil.RequireVLANMode(ctx, r.devices, req.Plan, req.State, sdk.VLANMode8021Q, "hrui_vlan_vid", &resp.Diagnostics)
+	if resp.Diagnostics.HasError() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan vlanVIDModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.Port.IsUnknown() || plan.VlanID.IsUnknown() || plan.AcceptFrameType.IsUnknown() {
+		return
+	}
+
+	providerutil.ValidatePVIDs(ctx, r.devices, req.Plan, req.State, "hrui_vlan_vid", false,
+		func(ctx context.Context, vlans []*sdk.Vlan, ports []*sdk.PortVLANConfig) ([]*sdk.Vlan, []string) {
+			for _, port := range ports {
+				if port.PortName == plan.Port.ValueString() {
+					port.PVID = int(plan.VlanID.ValueInt64())
+					port.AcceptFrameType = normalizeAcceptFrameType(plan.AcceptFrameType.ValueString())
+				}
+			}
+			return vlans, []string{plan.Port.ValueString()}
+		}, &resp.Diagnostics)
 }
 
 // Helper function to resolve PortID from Port Name.
@@ -111,6 +140,12 @@ func denormalizeAcceptFrameType(sdkValue string) string {
 
 // Create configures the port with the given VLAN ID and accepted frame type.
 func (r *vlanVIDResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	r.client = r.devices.Client(ctx, req.Plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer providerutil.KeepDevice(ctx, req.Plan, &resp.State, &resp.Diagnostics)
+
 	var plan vlanVIDModel
 
 	diags := req.Plan.Get(ctx, &plan)
@@ -153,6 +188,12 @@ func (r *vlanVIDResource) Create(ctx context.Context, req resource.CreateRequest
 
 // Read refreshes the Terraform state with the latest data.
 func (r *vlanVIDResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	r.client = r.devices.Client(ctx, req.State, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer providerutil.KeepDevice(ctx, req.State, &resp.State, &resp.Diagnostics)
+
 	var state vlanVIDModel
 
 	diags := req.State.Get(ctx, &state)
@@ -198,6 +239,12 @@ func (r *vlanVIDResource) Read(ctx context.Context, req resource.ReadRequest, re
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *vlanVIDResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	r.client = r.devices.Client(ctx, req.Plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer providerutil.KeepDevice(ctx, req.Plan, &resp.State, &resp.Diagnostics)
+
 	var plan vlanVIDModel
 
 	diags := req.Plan.Get(ctx, &plan)
@@ -240,6 +287,11 @@ func (r *vlanVIDResource) Update(ctx context.Context, req resource.UpdateRequest
 
 // Delete resets the port configuration to its default state (PVID = 1).
 func (r *vlanVIDResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	r.client = r.devices.Client(ctx, req.State, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var state vlanVIDModel
 
 	diags := req.State.Get(ctx, &state)
@@ -269,6 +321,14 @@ func (r *vlanVIDResource) Delete(ctx context.Context, req resource.DeleteRequest
 
 // ImportState imports an existing resource into Terraform.
 func (r *vlanVIDResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	device, importID := providerutil.SplitImportID(req.ID)
+	r.client = r.devices.Get(ctx, device, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer providerutil.SetDevice(ctx, device, &resp.State, &resp.Diagnostics)
+	req.ID = importID
+
 	tflog.Debug(ctx, "Importing VLAN VID", map[string]any{"id": req.ID})
 
 	resource.ImportStatePassthroughID(ctx, path.Root("port"), req, resp)
@@ -0,0 +1,101 @@
+package sdk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+// AdminAccount represents the credentials of the web interface administrator.
+type AdminAccount struct {
+	Username string
+	Password string
+}
+
+// GetAdminUsername retrieves the administrator username from account.cgi.
+// The device never shows the password.
+func (c *HRUIClient) GetAdminUsername(ctx context.Context) (string, error) {
+	endpoint := fmt.Sprintf("%s/account.cgi", c.BaseURL())
+	respBody, err := c.Request(ctx, "GET", endpoint, nil, nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to fetch account page: %w", err)
+	}
+
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(string(respBody)))
+	if err != nil {
+		return "", fmt.Errorf("failed to parse account page: %w", err)
+	}
+
+	const selector = "input[name='username']"
+	username, ok := doc.Find(selector).Attr("value")
+	if !ok {
+		return "", &ParseError{Page: "/account.cgi", Selector: selector}
+	}
+	return strings.TrimSpace(username), nil
+}
+
+// SetAdminAccount changes the administrator credentials. The device authenticates with a cookie
+// derived from the credentials, so on success the client switches to the new credentials and
+// logs in again before the change is saved. If that login fails, the client goes back to the
+// previous credentials. Other requests of the client wait until the change is done, as they would
+// otherwise log in again with credentials that are no longer valid.
+func (c *HRUIClient) SetAdminAccount(ctx context.Context, account *AdminAccount) error {
+	if account.Username == "" || account.Password == "" {
+		return errors.New("admin account needs a username and a password")
+	}
+
+	return c.ownSession(ctx, func(ctx context.Context) error {
+		return c.changeAdminAccount(ctx, account)
+	})
+}
+
+// changeAdminAccount implements SetAdminAccount while holding the session lock.
+func (c *HRUIClient) changeAdminAccount(ctx context.Context, account *AdminAccount) error {
+	// Renew an expired session first, the form below is sent without re-login handling.
+	endpoint := fmt.Sprintf("%s/account.cgi", c.BaseURL())
+	if _, err := c.request(ctx, "GET", endpoint, nil, nil, true); err != nil {
+		return fmt.Errorf("failed to fetch account page: %w", err)
+	}
+
+	currentUsername, currentPassword := c.credentials()
+
+	form := url.Values{}
+	form.Set("username", account.Username)
+	form.Set("old_pass", currentPassword)
+	form.Set("new_pass", account.Password)
+	form.Set("renew_pass", account.Password)
+	form.Set("cmd", "account")
+
+	// Send the form without the usual re-login handling: the response may already
+	// redirect to the login page, as the old session is no longer valid.
+	headers := map[string]string{
+		"Content-Type": "application/x-www-form-urlencoded",
+	}
+	respBody, err := c.do(ctx, "POST", endpoint, []byte(form.Encode()), headers)
+	c.cache.flush(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to update admin account: %w", err)
+	}
+	if err := checkDeviceAlert(endpoint, respBody); err != nil {
+		return fmt.Errorf("failed to update admin account: %w", err)
+	}
+
+	c.SetCredentials(account.Username, account.Password)
+	if err := c.Login(ctx); err != nil {
+		c.SetCredentials(currentUsername, currentPassword)
+		if restoreErr := c.Login(ctx); restoreErr != nil {
+			return fmt.Errorf("logging in with the new admin credentials failed: %w; logging in with the previous credentials failed as well: %w", err, restoreErr)
+		}
+		return fmt.Errorf("logging in with the new admin credentials failed, kept the previous credentials: %w", err)
+	}
+
+	if err := c.saveChanges(ctx); err != nil {
+		return fmt.Errorf("admin account updated, but saving configuration failed: %w", err)
+	}
+
+	return nil
+}
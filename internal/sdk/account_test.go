@@ -0,0 +1,177 @@
+package sdk
+
+import (
+	"context"
+	"crypto/md5" //#nosec G501
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// accountServer emulates the cookie authentication of the device: requests are accepted only
+// with the MD5 cookie of the current credentials, which account.cgi can change.
+func accountServer(t *testing.T, username, password string) (*httptest.Server, *int) {
+	saves := 0
+	cookie := func(user, pass string) string {
+		//#nosec G401
+		hash := md5.Sum([]byte(user + pass))
+		return hex.EncodeToString(hash[:])
+	}
+	loginRedirect := `<script type="text/javascript">window.top.location.replace("/login.cgi");</script>`
+
+	var mu sync.Mutex
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		require.NoError(t, r.ParseForm())
+		authenticated := false
+		if c, err := r.Cookie("admin"); err == nil {
+			authenticated = c.Value == cookie(username, password)
+		}
+
+		switch {
+		case r.URL.Path == "/login.cgi" && r.Method == http.MethodPost:
+			if r.PostForm.Get("Response") != cookie(username, password) {
+				w.WriteHeader(http.StatusUnauthorized)
+			}
+		case !authenticated:
+			_, _ = w.Write([]byte(loginRedirect))
+		case r.URL.Path == "/account.cgi" && r.Method == http.MethodPost:
+			if r.PostForm.Get("old_pass") != password {
+				_, _ = w.Write([]byte(`<script>window.location.href='alert.cgi?alertmsg=Old%20password%20is%20wrong'</script>`))
+				return
+			}
+			username, password = r.PostForm.Get("username"), r.PostForm.Get("new_pass")
+			_, _ = w.Write([]byte(loginRedirect))
+		case r.URL.Path == "/account.cgi":
+			_, _ = w.Write([]byte(`<form><input type="text" name="username" value="` + username + `"></form>`))
+		case r.URL.Path == "/save.cgi":
+			saves++
+		}
+	}))
+
+	return server, &saves
+}
+
+func TestSetAdminAccount(t *testing.T) {
+	server, saves := accountServer(t, "admin", "old-secret")
+	defer server.Close()
+
+	client, err := NewClient(context.Background(), server.URL, "admin", "old-secret", true, nil, WithFirmwareDetection(false))
+	require.NoError(t, err)
+
+	err = client.SetAdminAccount(context.Background(), &AdminAccount{Username: "ops", Password: "new-secret"})
+	require.NoError(t, err)
+	assert.Equal(t, "ops", client.Username)
+	assert.Equal(t, "new-secret", client.Password)
+	assert.Equal(t, 1, *saves, "the change should be saved with the new session")
+
+	// The rest of the apply keeps working with the new credentials.
+	username, err := client.GetAdminUsername(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "ops", username)
+}
+
+func TestSetAdminAccount_Rejected(t *testing.T) {
+	server, _ := accountServer(t, "admin", "secret")
+	defer server.Close()
+
+	client, err := NewClient(context.Background(), server.URL, "admin", "secret", false, nil, WithFirmwareDetection(false))
+	require.NoError(t, err)
+	client.Password = "stale"
+
+	err = client.SetAdminAccount(context.Background(), &AdminAccount{Username: "admin", Password: "new-secret"})
+	var alert *DeviceAlertError
+	require.ErrorAs(t, err, &alert)
+	assert.Equal(t, "Old password is wrong", alert.Message)
+	assert.Equal(t, "stale", client.Password, "credentials must not change when the device rejects the form")
+}
+
+func TestSetAdminAccount_ExpiredSession(t *testing.T) {
+	server, saves := accountServer(t, "admin", "old-secret")
+	defer server.Close()
+
+	client, err := NewClient(context.Background(), server.URL, "admin", "old-secret", true, nil, WithFirmwareDetection(false))
+	require.NoError(t, err)
+
+	// Invalidate the session cookie; the form must not be sent with it.
+	u, err := url.Parse(server.URL)
+	require.NoError(t, err)
+	client.HttpClient.Jar.SetCookies(u, []*http.Cookie{{Name: "admin", Value: "expired", Path: "/"}})
+
+	err = client.SetAdminAccount(context.Background(), &AdminAccount{Username: "ops", Password: "new-secret"})
+	require.NoError(t, err)
+	assert.Equal(t, "ops", client.Username)
+	assert.Equal(t, 1, *saves)
+}
+
+func TestSetAdminAccount_RestoresCredentials(t *testing.T) {
+	// The device answers the form, but keeps the old credentials.
+	server, _ := accountServer(t, "admin", "old-secret")
+	defer server.Close()
+	ignoring := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/account.cgi" && r.Method == http.MethodPost {
+			return
+		}
+		server.Config.Handler.ServeHTTP(w, r)
+	}))
+	defer ignoring.Close()
+
+	client, err := NewClient(context.Background(), ignoring.URL, "admin", "old-secret", true, nil, WithFirmwareDetection(false))
+	require.NoError(t, err)
+
+	err = client.SetAdminAccount(context.Background(), &AdminAccount{Username: "ops", Password: "new-secret"})
+	require.ErrorIs(t, err, ErrAuth)
+	assert.Equal(t, "admin", client.Username)
+	assert.Equal(t, "old-secret", client.Password)
+
+	// The client keeps working with the previous credentials.
+	username, err := client.GetAdminUsername(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "admin", username)
+}
+
+func TestSetAdminAccount_ConcurrentRequests(t *testing.T) {
+	server, _ := accountServer(t, "admin", "old-secret")
+	defer server.Close()
+
+	// Hold back the answer to the form, so another request comes in after the device switched
+	// to the new credentials but before the client did.
+	posted := make(chan struct{})
+	slow := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		server.Config.Handler.ServeHTTP(w, r)
+		if r.URL.Path == "/account.cgi" && r.Method == http.MethodPost {
+			close(posted)
+			time.Sleep(50 * time.Millisecond)
+		}
+	}))
+	defer slow.Close()
+
+	client, err := NewClient(context.Background(), slow.URL, "admin", "old-secret", false, nil,
+		WithFirmwareDetection(false), WithMaxConcurrentRequests(2))
+	require.NoError(t, err)
+
+	done := make(chan error)
+	go func() {
+		done <- client.SetAdminAccount(context.Background(), &AdminAccount{Username: "ops", Password: "new-secret"})
+	}()
+
+	<-posted
+	username, err := client.GetAdminUsername(context.Background())
+	require.NoError(t, err, "the request must wait for the new credentials instead of logging in with the old ones")
+	assert.Equal(t, "ops", username)
+	require.NoError(t, <-done)
+}
+
+func TestSetAdminAccount_RequiresCredentials(t *testing.T) {
+	client := &HRUIClient{URL: "http://127.0.0.1:0"}
+	assert.Error(t, client.SetAdminAccount(context.Background(), &AdminAccount{Username: "admin"}))
+}
@@ -0,0 +1,74 @@
+package sdk
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"mime/multipart"
+)
+
+// configBackupFileName is the file name the web UI uses when uploading a configuration.
+const configBackupFileName = "switch_cfg.bin"
+
+// BackupConfig downloads the configuration file of the switch, as offered by the
+// Backup/Restore page of the web UI.
+func (c *HRUIClient) BackupConfig(ctx context.Context) ([]byte, error) {
+	endpoint := fmt.Sprintf("%s/config_back.cgi?cmd=conf_backup", c.BaseURL())
+	respBody, err := c.Request(ctx, "GET", endpoint, nil, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to download configuration backup: %w", err)
+	}
+
+	// The device answers with an HTML page instead of the file if the backup failed.
+	if len(respBody) == 0 || isHTMLPage(respBody) {
+		return nil, errors.New("failed to download configuration backup: device did not return a configuration file")
+	}
+
+	return respBody, nil
+}
+
+// RestoreConfig uploads a configuration file previously returned by BackupConfig.
+// Depending on the firmware, the switch may need a reboot to apply it.
+func (c *HRUIClient) RestoreConfig(ctx context.Context, config []byte) error {
+	if len(config) == 0 {
+		return errors.New("configuration to restore is empty")
+	}
+
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+	if err := writer.WriteField("cmd", "conf_restore"); err != nil {
+		return fmt.Errorf("failed to build restore request: %w", err)
+	}
+	part, err := writer.CreateFormFile("file", configBackupFileName)
+	if err != nil {
+		return fmt.Errorf("failed to build restore request: %w", err)
+	}
+	if _, err := part.Write(config); err != nil {
+		return fmt.Errorf("failed to build restore request: %w", err)
+	}
+	if err := writer.Close(); err != nil {
+		return fmt.Errorf("failed to build restore request: %w", err)
+	}
+
+	// Uploads are not retried: the device may already be applying the first one.
+	endpoint := fmt.Sprintf("%s/config_back.cgi", c.BaseURL())
+	headers := map[string]string{
+		"Content-Type": writer.FormDataContentType(),
+	}
+	respBody, err := c.send(ctx, "POST", endpoint, &body, headers, false)
+	if err != nil {
+		return fmt.Errorf("failed to restore configuration: %w", err)
+	}
+	if err := checkDeviceAlert(endpoint, respBody); err != nil {
+		return fmt.Errorf("failed to restore configuration: %w", err)
+	}
+
+	return nil
+}
+
+// isHTMLPage reports whether body looks like an HTML page rather than a file download.
+func isHTMLPage(body []byte) bool {
+	head := bytes.ToLower(bytes.TrimSpace(body[:min(len(body), 512)]))
+	return bytes.HasPrefix(head, []byte("<html")) || bytes.HasPrefix(head, []byte("<!doctype html")) || bytes.HasPrefix(head, []byte("<script"))
+}
@@ -0,0 +1,80 @@
+package sdk
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBackupConfig(t *testing.T) {
+	config := []byte{0x48, 0x52, 0x55, 0x49, 0x00, 0x01, 0xff}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/config_back.cgi", r.URL.Path)
+		assert.Equal(t, "conf_backup", r.URL.Query().Get("cmd"))
+		w.Header().Set("Content-Type", "application/octet-stream")
+		_, _ = w.Write(config)
+	}))
+	defer server.Close()
+
+	client := &HRUIClient{URL: server.URL, HttpClient: server.Client()}
+	backup, err := client.BackupConfig(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, config, backup)
+}
+
+func TestBackupConfig_HTMLResponse(t *testing.T) {
+	server := mockServerMock("<html><body>Backup failed</body></html>", http.StatusOK)
+	defer server.Close()
+
+	client := &HRUIClient{URL: server.URL, HttpClient: server.Client()}
+	_, err := client.BackupConfig(context.Background())
+
+	assert.Error(t, err)
+}
+
+func TestRestoreConfig(t *testing.T) {
+	config := []byte{0x48, 0x52, 0x55, 0x49, 0x00, 0x01, 0xff}
+	var uploaded []byte
+	var cmd string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		require.NoError(t, r.ParseMultipartForm(1<<20))
+		cmd = r.FormValue("cmd")
+		file, header, err := r.FormFile("file")
+		require.NoError(t, err)
+		assert.Equal(t, configBackupFileName, header.Filename)
+		uploaded, err = io.ReadAll(file)
+		require.NoError(t, err)
+	}))
+	defer server.Close()
+
+	client := &HRUIClient{URL: server.URL, HttpClient: server.Client()}
+	err := client.RestoreConfig(context.Background(), config)
+
+	require.NoError(t, err)
+	assert.Equal(t, "conf_restore", cmd)
+	assert.Equal(t, config, uploaded)
+}
+
+func TestRestoreConfig_DeviceAlert(t *testing.T) {
+	server := mockServerMock(`<script>window.location.href='alert.cgi?alertmsg=Invalid%20configuration%20file'</script>`, http.StatusOK)
+	defer server.Close()
+
+	client := &HRUIClient{URL: server.URL, HttpClient: server.Client()}
+	err := client.RestoreConfig(context.Background(), []byte("not a config"))
+
+	var alert *DeviceAlertError
+	require.ErrorAs(t, err, &alert)
+	assert.Equal(t, "Invalid configuration file", alert.Message)
+}
+
+func TestRestoreConfig_Empty(t *testing.T) {
+	client := &HRUIClient{URL: "http://127.0.0.1:0"}
+	assert.Error(t, client.RestoreConfig(context.Background(), nil))
+}
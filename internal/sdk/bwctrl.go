@@ -21,7 +21,7 @@ type BandwidthControl struct {
 // GetBandwidthControl retrieves the bandwidth control configuration for each port.
 func (c *HRUIClient) GetBandwidthControl(ctx context.Context) ([]BandwidthControl, error) {
 	// URL to the bandwidth control page
-	urlBw := fmt.Sprintf("%s/port.cgi?page=bw_ctrl", c.URL)
+	urlBw := fmt.Sprintf("%s/port.cgi?page=bw_ctrl", c.BaseURL())
 
 	// Perform HTTP GET request
 	respBody, err := c.Request(ctx, "GET", urlBw, nil, nil)
@@ -35,6 +35,11 @@ func (c *HRUIClient) GetBandwidthControl(ctx context.Context) ([]BandwidthContro
 		return nil, fmt.Errorf("failed to parse bandwidth control HTML: %w", err)
 	}
 
+	return c.parsers().bandwidthControl.parse(doc)
+}
+
+// parseBandwidthControlTable extracts the per-port rates from the last table of the bandwidth control page.
+func parseBandwidthControlTable(doc *goquery.Document) ([]BandwidthControl, error) {
 	// Slice to hold bandwidth control data
 	var controls []BandwidthControl
 
@@ -109,10 +114,10 @@ func (c *HRUIClient) configureBandwidthByID(ctx context.Context, portID int, isI
 	form.Set("submit", "+++Apply+++")        // Form submission button value
 
 	// Construct the POST request endpoint
-	endpoint := fmt.Sprintf("%s/port.cgi?page=bwctrl", c.URL)
+	endpoint := fmt.Sprintf("%s/port.cgi?page=bwctrl", c.BaseURL())
 
 	// Send the POST request
-	_, err := c.FormRequest(ctx, endpoint, form)
+	_, err := c.idempotentFormRequest(ctx, endpoint, form)
 	if err != nil {
 		return fmt.Errorf("failed to configure bandwidth control for port ID '%d': %w", portID, err)
 	}
@@ -0,0 +1,86 @@
+package sdk
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// DefaultPageCacheTTL is how long NewClient keeps GET responses by default.
+// It is long enough to cover the burst of reads during a single plan or refresh.
+const DefaultPageCacheTTL = 10 * time.Second
+
+// pageCache holds GET responses keyed by URL for a short time, so pages that are read
+// repeatedly (e.g. port.cgi when resolving port names) are only fetched once.
+type pageCache struct {
+	mu      sync.Mutex
+	entries map[string]pageCacheEntry
+	hits    int
+	misses  int
+
+	// generation counts the flushes, so a read that overlapped a write is not cached.
+	generation uint64
+}
+
+type pageCacheEntry struct {
+	body    []byte
+	expires time.Time
+}
+
+// get returns the cached body for the URL if it has not expired yet.
+func (pc *pageCache) get(ctx context.Context, endpoint string) ([]byte, bool) {
+	pc.mu.Lock()
+	defer pc.mu.Unlock()
+
+	entry, ok := pc.entries[endpoint]
+	if ok && time.Now().Before(entry.expires) {
+		pc.hits++
+		tflog.Debug(ctx, "Page cache hit", map[string]any{"endpoint": endpoint, "cache_hits": pc.hits, "cache_misses": pc.misses})
+		return entry.body, true
+	}
+
+	delete(pc.entries, endpoint)
+	pc.misses++
+	tflog.Debug(ctx, "Page cache miss", map[string]any{"endpoint": endpoint, "cache_hits": pc.hits, "cache_misses": pc.misses})
+	return nil, false
+}
+
+// currentGeneration returns the generation to pass to put for a page that is about to be fetched.
+func (pc *pageCache) currentGeneration() uint64 {
+	pc.mu.Lock()
+	defer pc.mu.Unlock()
+	return pc.generation
+}
+
+// put stores the body for the URL until the TTL expires. The body is dropped if the cache was
+// flushed since generation was taken: the page may have been read before the write that flushed
+// it, and caching it would serve the state from before the write.
+func (pc *pageCache) put(endpoint string, body []byte, ttl time.Duration, generation uint64) {
+	pc.mu.Lock()
+	defer pc.mu.Unlock()
+
+	if generation != pc.generation {
+		return
+	}
+
+	if pc.entries == nil {
+		pc.entries = make(map[string]pageCacheEntry)
+	}
+	pc.entries[endpoint] = pageCacheEntry{body: body, expires: time.Now().Add(ttl)}
+}
+
+// flush drops all cached pages. Pages of different CGIs reference each other (trunks show up
+// on port.cgi and the VLAN pages, for instance), so any write invalidates the whole cache
+// rather than only the pages of the CGI that was posted to.
+func (pc *pageCache) flush(ctx context.Context) {
+	pc.mu.Lock()
+	defer pc.mu.Unlock()
+
+	if len(pc.entries) > 0 {
+		tflog.Debug(ctx, "Page cache flushed", map[string]any{"entries": len(pc.entries)})
+	}
+	pc.entries = nil
+	pc.generation++
+}
@@ -0,0 +1,135 @@
+package sdk
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// countingServer counts GET requests per path and answers every request successfully.
+func countingServer() (*httptest.Server, map[string]int) {
+	gets := map[string]int{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			gets[r.URL.Path]++
+		}
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(r.URL.Path))
+	}))
+	return server, gets
+}
+
+func TestPageCache_ServesRepeatedGets(t *testing.T) {
+	server, gets := countingServer()
+	defer server.Close()
+
+	client := &HRUIClient{URL: server.URL, HttpClient: server.Client(), PageCacheTTL: time.Minute}
+
+	for range 5 {
+		body, err := client.Request(context.Background(), "GET", server.URL+"/port.cgi", nil, nil)
+		require.NoError(t, err)
+		require.Equal(t, "/port.cgi", string(body))
+	}
+
+	require.Equal(t, 1, gets["/port.cgi"])
+	require.Equal(t, 4, client.cache.hits)
+	require.Equal(t, 1, client.cache.misses)
+}
+
+func TestPageCache_FlushedByFormRequest(t *testing.T) {
+	server, gets := countingServer()
+	defer server.Close()
+
+	client := &HRUIClient{URL: server.URL, HttpClient: server.Client(), PageCacheTTL: time.Minute}
+
+	_, err := client.Request(context.Background(), "GET", server.URL+"/port.cgi", nil, nil)
+	require.NoError(t, err)
+
+	_, err = client.FormRequest(context.Background(), server.URL+"/port.cgi", url.Values{"cmd": {"port"}})
+	require.NoError(t, err)
+
+	_, err = client.Request(context.Background(), "GET", server.URL+"/port.cgi", nil, nil)
+	require.NoError(t, err)
+
+	require.Equal(t, 2, gets["/port.cgi"])
+}
+
+func TestPageCache_SkipsReadOverlappingWrite(t *testing.T) {
+	getReceived := make(chan struct{})
+	posted := make(chan struct{})
+	var state atomic.Value
+	state.Store("old")
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			state.Store("new")
+			_, _ = w.Write([]byte("ok"))
+			return
+		}
+		body := state.Load().(string)
+		if body == "old" {
+			// Answer with the state from before the write, but only after the write was flushed.
+			close(getReceived)
+			<-posted
+		}
+		_, _ = w.Write([]byte(body))
+	}))
+	defer server.Close()
+
+	client := &HRUIClient{URL: server.URL, HttpClient: server.Client(), PageCacheTTL: time.Minute, MaxConcurrentRequests: 2}
+
+	done := make(chan []byte)
+	go func() {
+		body, err := client.Request(context.Background(), "GET", server.URL+"/port.cgi", nil, nil)
+		assert.NoError(t, err)
+		done <- body
+	}()
+
+	<-getReceived
+	_, err := client.Request(context.Background(), "POST", server.URL+"/port.cgi", nil, nil)
+	require.NoError(t, err)
+	close(posted)
+	require.Equal(t, "old", string(<-done))
+
+	body, err := client.Request(context.Background(), "GET", server.URL+"/port.cgi", nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, "new", string(body), "the read that overlapped the write must not be cached")
+}
+
+func TestPageCache_ExpiresAfterTTL(t *testing.T) {
+	server, gets := countingServer()
+	defer server.Close()
+
+	client := &HRUIClient{URL: server.URL, HttpClient: server.Client(), PageCacheTTL: 10 * time.Millisecond}
+
+	_, err := client.Request(context.Background(), "GET", server.URL+"/vlan.cgi?page=static", nil, nil)
+	require.NoError(t, err)
+
+	time.Sleep(20 * time.Millisecond)
+
+	_, err = client.Request(context.Background(), "GET", server.URL+"/vlan.cgi?page=static", nil, nil)
+	require.NoError(t, err)
+
+	require.Equal(t, 2, gets["/vlan.cgi"])
+}
+
+func TestPageCache_DisabledByDefault(t *testing.T) {
+	server, gets := countingServer()
+	defer server.Close()
+
+	client := &HRUIClient{URL: server.URL, HttpClient: server.Client()}
+
+	for range 3 {
+		_, err := client.Request(context.Background(), "GET", server.URL+"/port.cgi", nil, nil)
+		require.NoError(t, err)
+	}
+
+	require.Equal(t, 3, gets["/port.cgi"])
+}
@@ -0,0 +1,169 @@
+package sdk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Capabilities describes which optional features the firmware of the device supports.
+type Capabilities struct {
+	FirmwareVersion string
+	HardwareVersion string
+
+	// Known reports whether the firmware version is part of the capability matrix.
+	// Unknown firmware is assumed to support every feature that is known to work on some firmware.
+	Known bool
+
+	JumboFrame16383 bool
+	TenGigabitPorts bool
+	LACP            bool
+
+	// SystemSettings reports whether info.cgi has a form for the system name, location and
+	// contact. The recorded info.cgi of 1.9.1 is a read-only table, and no firmware is known to
+	// have the form, so it is false everywhere until the page that edits them is recorded.
+	SystemSettings bool
+
+	// VLANMode reports whether vlan.cgi has the VLAN mode selector and the port-based VLAN pages.
+	// No firmware is known to have them: they are not in any recording, and the other forms of
+	// the web UI post a hidden cmd field that these lack. It stays false until they are recorded.
+	VLANMode bool
+
+	// ManagementVLAN reports whether ip.cgi has a management VLAN setting. The recorded ip.cgi form
+	// has the address fields only, so it stays false until a page with the setting is recorded.
+	ManagementVLAN bool
+
+	// IPv6 reports whether ip.cgi has the IPv6 settings of the management interface. The recorded
+	// ip.cgi form has the IPv4 address fields only, so it stays false until the IPv6 page is recorded.
+	IPv6 bool
+
+	// MACAging reports whether mac.cgi?page=fwd_tbl has an aging time setting. No recorded page has
+	// it, and the other forms of the web UI post a hidden cmd field that it lacks, so it stays false
+	// until a page with the setting is recorded.
+	MACAging bool
+
+	// jumboFrameOptions maps frame sizes to the option values posted to fwd.cgi.
+	// It is nil when the layout for the firmware is not known.
+	jumboFrameOptions map[int]string
+}
+
+// firmwareCapabilities is the capability matrix of the firmware versions the provider is tested against,
+// keyed by the version string shown on info.cgi without the leading "V".
+var firmwareCapabilities = map[string]Capabilities{
+	"1.9": {
+		JumboFrame16383: true,
+		TenGigabitPorts: true,
+		LACP:            true,
+		jumboFrameOptions: map[int]string{
+			1522: "0", 1536: "1", 1552: "2", 9216: "3", 16383: "4",
+		},
+	},
+	"1.9.1": {
+		JumboFrame16383: true,
+		TenGigabitPorts: true,
+		LACP:            true,
+		jumboFrameOptions: map[int]string{
+			1522: "1", 1536: "2", 1552: "3", 9216: "4", 16383: "5",
+		},
+	},
+}
+
+// DefaultCapabilities returns the capabilities assumed when the firmware could not be identified.
+// Features that no firmware is known to have stay disabled.
+func DefaultCapabilities() Capabilities {
+	return Capabilities{
+		JumboFrame16383: true,
+		TenGigabitPorts: true,
+		LACP:            true,
+	}
+}
+
+// normalizeFirmwareVersion turns a version shown on info.cgi, such as "V1.9.1", into a matrix key.
+func normalizeFirmwareVersion(version string) string {
+	return strings.TrimPrefix(strings.ToLower(strings.TrimSpace(version)), "v")
+}
+
+// capabilitiesFor looks up the capabilities of the given firmware version.
+func capabilitiesFor(firmwareVersion, hardwareVersion string) Capabilities {
+	caps, ok := firmwareCapabilities[normalizeFirmwareVersion(firmwareVersion)]
+	if !ok {
+		caps = DefaultCapabilities()
+	}
+	caps.Known = ok
+	caps.FirmwareVersion = firmwareVersion
+	caps.HardwareVersion = hardwareVersion
+	return caps
+}
+
+// Capabilities returns the capabilities detected for the device, or DefaultCapabilities
+// if detection has not run.
+func (c *HRUIClient) Capabilities() Capabilities {
+	caps := c.capabilities.Load()
+	if caps == nil {
+		return DefaultCapabilities()
+	}
+	return *caps
+}
+
+// setCapabilities records the capabilities of the device.
+func (c *HRUIClient) setCapabilities(caps Capabilities) {
+	c.capabilities.Store(&caps)
+}
+
+// DetectCapabilities reads the firmware and hardware version from info.cgi and records
+// the capabilities of the device.
+func (c *HRUIClient) DetectCapabilities(ctx context.Context) (Capabilities, error) {
+	info, err := c.GetSystemInfo(ctx)
+	if err != nil {
+		return Capabilities{}, fmt.Errorf("failed to detect firmware version: %w", err)
+	}
+
+	if info.FirmwareVersion == "" {
+		return Capabilities{}, errors.New("failed to detect firmware version: not shown on info.cgi")
+	}
+
+	caps := capabilitiesFor(info.FirmwareVersion, info.HardwareVersion)
+	if !caps.Known {
+		tflog.Warn(ctx, "Unknown HRUI firmware version, assuming all features are supported", map[string]any{
+			"firmware_version": caps.FirmwareVersion,
+		})
+	} else {
+		tflog.Debug(ctx, "Detected HRUI firmware", map[string]any{
+			"firmware_version": caps.FirmwareVersion,
+			"hardware_version": caps.HardwareVersion,
+		})
+	}
+
+	c.setCapabilities(caps)
+	return caps, nil
+}
+
+// requireCapability returns ErrUnsupportedFeature for feature if supported is false.
+func (c *HRUIClient) requireCapability(supported bool, feature string) error {
+	if supported {
+		return nil
+	}
+	version := c.Capabilities().FirmwareVersion
+	if version == "" {
+		version = "of unknown version"
+	}
+	return fmt.Errorf("%w: %s is not available on firmware %s", ErrUnsupportedFeature, feature, version)
+}
+
+// optionalPageError maps an error from parsing the page of an optional feature. A ParseError
+// means the page lacks the form of the feature. It is reported as ErrUnsupportedFeature if the
+// firmware is unknown or supported is false; on firmware known to have the feature the page
+// layout is unexpected, and the ParseError is returned as is.
+func (c *HRUIClient) optionalPageError(err error, supported bool, feature string) error {
+	var parseErr *ParseError
+	if !errors.As(err, &parseErr) {
+		return err
+	}
+	if c.Capabilities().Known && supported {
+		return err
+	}
+	return c.requireCapability(false, feature)
+}
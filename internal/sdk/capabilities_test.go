@@ -0,0 +1,120 @@
+package sdk
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func systemInfoHTML(firmwareVersion string) string {
+	return `<table>
+		<tr><th>Device Model</th><td>HR-SW1</td></tr>
+		<tr><th>Firmware Version</th><td>` + firmwareVersion + `</td></tr>
+		<tr><th>Hardware Version</th><td>V1.0</td></tr>
+	</table>`
+}
+
+func TestDetectCapabilities_KnownFirmware(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(systemInfoHTML("V1.9.1")))
+	}))
+	defer server.Close()
+
+	client := &HRUIClient{URL: server.URL, HttpClient: server.Client()}
+	caps, err := client.DetectCapabilities(context.Background())
+	require.NoError(t, err)
+	require.True(t, caps.Known)
+	require.Equal(t, "V1.9.1", caps.FirmwareVersion)
+	require.Equal(t, "V1.0", caps.HardwareVersion)
+	require.Equal(t, "5", caps.jumboFrameOptions[16383])
+	require.Equal(t, caps, client.Capabilities())
+}
+
+func TestDetectCapabilities_UnknownFirmware(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(systemInfoHTML("V2.0")))
+	}))
+	defer server.Close()
+
+	client := &HRUIClient{URL: server.URL, HttpClient: server.Client()}
+	caps, err := client.DetectCapabilities(context.Background())
+	require.NoError(t, err)
+	require.False(t, caps.Known)
+	require.True(t, caps.JumboFrame16383)
+	require.True(t, caps.TenGigabitPorts)
+	require.True(t, caps.LACP)
+	require.Nil(t, caps.jumboFrameOptions)
+}
+
+func TestDetectCapabilities_ConcurrentReads(t *testing.T) {
+	server := mockServerMock(systemInfoHTML("V1.9.1"), http.StatusOK)
+	defer server.Close()
+
+	client := &HRUIClient{URL: server.URL, HttpClient: server.Client(), MaxConcurrentRequests: 2}
+
+	// Run with -race: detection replaces the capabilities while requests read them.
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := client.DetectCapabilities(context.Background())
+			assert.NoError(t, err)
+			_ = client.parsers()
+		}()
+	}
+	wg.Wait()
+	require.Equal(t, "V1.9.1", client.Capabilities().FirmwareVersion)
+}
+
+func TestDetectCapabilities_MissingVersion(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`<table></table>`))
+	}))
+	defer server.Close()
+
+	client := &HRUIClient{URL: server.URL, HttpClient: server.Client()}
+	_, err := client.DetectCapabilities(context.Background())
+	require.Error(t, err)
+	require.Equal(t, DefaultCapabilities(), client.Capabilities())
+}
+
+func TestSetJumboFrame_UsesDetectedOptionValues(t *testing.T) {
+	posts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			posts++
+			require.NoError(t, r.ParseForm())
+			require.Equal(t, "4", r.FormValue("jumboframe"))
+		}
+		_, _ = w.Write([]byte(`<html><head><title>Jumbo Frame Setting</title></head><body>
+			<select name="jumboframe">
+				<option value="1">1522</option>
+				<option value="4" selected>9216</option>
+			</select></body></html>`))
+	}))
+	defer server.Close()
+
+	caps := capabilitiesFor("V1.9.1", "V1.0")
+	client := &HRUIClient{URL: server.URL, HttpClient: server.Client()}
+	client.setCapabilities(caps)
+	appliedSize, err := client.SetJumboFrame(context.Background(), 9216)
+	require.NoError(t, err)
+	require.Equal(t, 9216, appliedSize)
+	require.Equal(t, 1, posts)
+}
+
+func TestConfigureTrunk_UnsupportedLACP(t *testing.T) {
+	caps := Capabilities{FirmwareVersion: "V1.0"}
+	client := &HRUIClient{URL: "http://example.com", HttpClient: http.DefaultClient}
+	client.setCapabilities(caps)
+
+	err := client.ConfigureTrunk(context.Background(), &TrunkConfig{ID: 1, Type: "LACP", Ports: []int{1, 2}})
+	require.ErrorIs(t, err, ErrUnsupportedFeature)
+	require.Contains(t, err.Error(), "LACP trunking is not available on firmware V1.0")
+}
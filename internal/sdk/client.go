@@ -1,8 +1,10 @@
 package sdk
 
 import (
+	"bytes"
 	"context"
 	"crypto/md5" //#nosec G501 -- HRUI switch auth requires it
+	"crypto/tls"
 	"encoding/hex"
 	"fmt"
 	"io"
@@ -11,51 +13,192 @@ import (
 	"net/url"
 	"regexp"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/PuerkitoBio/goquery"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
+// loginRedirectScript is served by the device in place of the requested page
+// whenever the authentication cookie is missing or no longer valid.
+const loginRedirectScript = `window.top.location.replace("/login.cgi")`
+
+// Autosave modes supported by HRUIClient.
+const (
+	// AutosaveImmediate saves the configuration after every successful form submission.
+	AutosaveImmediate = "immediate"
+	// AutosaveBatch only records that the configuration changed; the changes are written
+	// to flash by a single CommitPendingChanges call.
+	AutosaveBatch = "batch"
+)
+
+// DefaultRequestTimeout bounds a single request to the device, including reading the response.
+const DefaultRequestTimeout = 30 * time.Second
+
 // Client handles communication with the HRUI device, managing VLANs and other networking functionality.
 type HRUIClient struct {
+	// URL is the base URL of the device. ChangeIPAddressSettings may change it while other
+	// requests run, so once the client is in use it is read through BaseURL.
 	URL        string
 	Username   string
 	Password   string
 	Autosave   bool
 	HttpClient *http.Client
+
+	// MaxConcurrentRequests limits how many requests may be in flight against the device at once.
+	// The CGI backend is single-threaded, so values below 1 serialize every request.
+	MaxConcurrentRequests int
+
+	// AutosaveMode selects when changes are saved if Autosave is enabled.
+	// An empty value behaves like AutosaveImmediate.
+	AutosaveMode string
+
+	// RetryPolicy controls how requests failing with a transient error are retried.
+	// It applies to GET requests and to form submissions known to be idempotent.
+	RetryPolicy RetryPolicy
+
+	// RequestTimeout bounds every attempt of a request, on top of any deadline of the caller's
+	// context. Zero leaves requests bounded by the context only.
+	RequestTimeout time.Duration
+
+	// PageCacheTTL is how long GET responses are served from cache. Zero disables caching.
+	PageCacheTTL time.Duration
+
+	// DetectFirmware makes NewClient read the firmware version from info.cgi to determine
+	// the capabilities of the device.
+	DetectFirmware bool
+
+	// FollowAddressChanges makes ChangeIPAddressSettings point the client at the new address of the
+	// switch and wait for it there. Otherwise ChangeIPAddressSettings behaves like SetIPAddressSettings.
+	FollowAddressChanges bool
+
+	// TLSConfig and ProxyURL configure the HTTP client created by NewClient.
+	// They are ignored when NewClient is given an HTTP client.
+	TLSConfig *tls.Config
+	ProxyURL  *url.URL
+
+	// capabilities is replaced by DetectCapabilities and UpgradeFirmware while other
+	// requests may read it.
+	capabilities atomic.Pointer[Capabilities]
+
+	slotsOnce sync.Once
+	slots     chan struct{}
+
+	// operation serializes read-modify-write operations, see exclusive.
+	operationOnce sync.Once
+	operation     chan struct{}
+
+	cache pageCache
+
+	pendingMu  sync.Mutex
+	pending    bool
+	batchEnded bool
+
+	// credentialsMu guards Username and Password once the client is in use.
+	credentialsMu sync.RWMutex
+
+	// session is held for reading by every request and for writing while the credentials of
+	// the device change, see shareSession and ownSession.
+	session sync.RWMutex
+
+	// urlMu guards URL once the client is in use.
+	urlMu sync.RWMutex
+}
+
+// ClientOption configures optional HRUIClient behaviour in NewClient.
+type ClientOption func(*HRUIClient)
+
+// WithMaxConcurrentRequests sets how many requests may be sent to the device concurrently.
+func WithMaxConcurrentRequests(n int) ClientOption {
+	return func(c *HRUIClient) {
+		c.MaxConcurrentRequests = n
+	}
+}
+
+// WithAutosaveMode sets when changes are saved if autosave is enabled.
+func WithAutosaveMode(mode string) ClientOption {
+	return func(c *HRUIClient) {
+		c.AutosaveMode = mode
+	}
+}
+
+// WithPageCacheTTL sets how long GET responses are cached. Zero disables the cache.
+func WithPageCacheTTL(ttl time.Duration) ClientOption {
+	return func(c *HRUIClient) {
+		c.PageCacheTTL = ttl
+	}
+}
+
+// WithRequestTimeout sets the timeout of a single request attempt. Zero disables it.
+func WithRequestTimeout(timeout time.Duration) ClientOption {
+	return func(c *HRUIClient) {
+		c.RequestTimeout = timeout
+	}
+}
+
+// WithRetryPolicy sets the retry policy for transient request failures.
+func WithRetryPolicy(policy RetryPolicy) ClientOption {
+	return func(c *HRUIClient) {
+		c.RetryPolicy = policy
+	}
+}
+
+// WithFirmwareDetection enables or disables firmware detection in NewClient.
+func WithFirmwareDetection(enabled bool) ClientOption {
+	return func(c *HRUIClient) {
+		c.DetectFirmware = enabled
+	}
+}
+
+// WithAddressChangeFollowing enables or disables following the switch to its new address in
+// ChangeIPAddressSettings.
+func WithAddressChangeFollowing(enabled bool) ClientOption {
+	return func(c *HRUIClient) {
+		c.FollowAddressChanges = enabled
+	}
 }
 
 // NewClient initializes and authenticates a new HRUIClient.
 // If httpClient is nil, a new HTTP client will be created. Otherwise, the provided client is used.
-func NewClient(ctx context.Context, url, username, password string, autosave bool, httpClient *http.Client) (*HRUIClient, error) {
+func NewClient(ctx context.Context, url, username, password string, autosave bool, httpClient *http.Client, opts ...ClientOption) (*HRUIClient, error) {
 	jar, err := cookiejar.New(nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create cookie jar: %w", err)
 	}
 
+	// Initialize the client
+	client := &HRUIClient{
+		URL:            url,
+		Username:       username,
+		Password:       password,
+		Autosave:       autosave,
+		RequestTimeout: DefaultRequestTimeout,
+		RetryPolicy:    DefaultRetryPolicy(),
+		PageCacheTTL:   DefaultPageCacheTTL,
+		DetectFirmware: true,
+
+		FollowAddressChanges: true,
+	}
+
+	for _, opt := range opts {
+		opt(client)
+	}
+
 	// Determine which HTTP client to use
-	var clientHttpClient *http.Client
 	if httpClient == nil {
 		// Production: create a new HTTP client
-		clientHttpClient = &http.Client{
-			Jar:     jar,
-			Timeout: 30 * time.Second,
+		// Timeouts are applied per request through the context, see RequestTimeout.
+		client.HttpClient = &http.Client{
+			Jar:       jar,
+			Transport: client.newTransport(),
 		}
 	} else {
 		// Testing: use the provided HTTP client but ensure it has the cookie jar
 		// Always set the jar to the newly created one to ensure proper cookie handling
-		clientHttpClient = httpClient
-		clientHttpClient.Jar = jar
-	}
-
-	// Initialize the client
-	client := &HRUIClient{
-		URL:        url,
-		Username:   username,
-		Password:   password,
-		Autosave:   autosave,
-		HttpClient: clientHttpClient,
+		client.HttpClient = httpClient
+		client.HttpClient.Jar = jar
 	}
 
 	// Authenticate the client using the provided context
@@ -68,15 +211,27 @@ func NewClient(ctx context.Context, url, username, password string, autosave boo
 		return nil, fmt.Errorf("HttpClient was not initialized")
 	}
 
+	// Firmware detection is best effort: a device whose info page cannot be read is
+	// still managed, with every feature assumed to be available.
+	if client.DetectFirmware {
+		if _, err := client.DetectCapabilities(ctx); err != nil {
+			tflog.Warn(ctx, "Unable to detect HRUI firmware, assuming default capabilities", map[string]any{
+				"error": err.Error(),
+			})
+		}
+	}
+
 	return client, nil
 }
 
 // Login sets the authentication cookie for the HRUI system.
 func (c *HRUIClient) Login(ctx context.Context) error {
+	username, password := c.credentials()
+
 	// Generate MD5 hash of the username + password (as per HRUI spec).
 	//#nosec G401
 	// nosemgrep use-of-md5
-	hash := md5.Sum([]byte(c.Username + c.Password))
+	hash := md5.Sum([]byte(username + password))
 	cookieValue := hex.EncodeToString(hash[:])
 
 	// Create authentication cookie
@@ -89,7 +244,7 @@ func (c *HRUIClient) Login(ctx context.Context) error {
 	}
 
 	// Parse the base URL
-	u, err := url.Parse(c.URL)
+	u, err := url.Parse(c.BaseURL())
 	if err != nil {
 		return fmt.Errorf("error parsing URL: %w", err)
 	}
@@ -98,14 +253,64 @@ func (c *HRUIClient) Login(ctx context.Context) error {
 	c.HttpClient.Jar.SetCookies(u, []*http.Cookie{authCookie})
 
 	// Construct the login.cgi URL
-	loginURL := fmt.Sprintf("%s/login.cgi", strings.TrimSuffix(c.URL, "/"))
+	loginURL := fmt.Sprintf("%s/login.cgi", strings.TrimSuffix(c.BaseURL(), "/"))
 
 	// Prepare POST form data
 	formData := url.Values{}
-	formData.Set("username", c.Username)
+	formData.Set("username", username)
 	formData.Set("Response", cookieValue)
 	formData.Set("language", "EN")
 
+	if err := c.postLogin(ctx, loginURL, formData); err != nil {
+		return err
+	}
+
+	return c.ValidateAuthCookie(ctx)
+}
+
+// credentials returns the username and password used to log in.
+func (c *HRUIClient) credentials() (string, string) {
+	c.credentialsMu.RLock()
+	defer c.credentialsMu.RUnlock()
+	return c.Username, c.Password
+}
+
+// BaseURL returns the base URL of the device.
+func (c *HRUIClient) BaseURL() string {
+	c.urlMu.RLock()
+	defer c.urlMu.RUnlock()
+	return c.URL
+}
+
+// setBaseURL points the client at another address of the device.
+func (c *HRUIClient) setBaseURL(baseURL string) {
+	c.urlMu.Lock()
+	defer c.urlMu.Unlock()
+	c.URL = baseURL
+}
+
+// SetCredentials changes the username and password used by later logins.
+func (c *HRUIClient) SetCredentials(username, password string) {
+	c.credentialsMu.Lock()
+	defer c.credentialsMu.Unlock()
+	c.Username, c.Password = username, password
+}
+
+// postLogin submits the login form. It holds a request slot only for the duration of the POST,
+// so the validation request that follows can be scheduled.
+func (c *HRUIClient) postLogin(ctx context.Context, loginURL string, formData url.Values) error {
+	release, err := c.acquire(ctx)
+	if err != nil {
+		return fmt.Errorf("error waiting to send POST request to login.cgi: %w", err)
+	}
+	defer release()
+
+	if c.RequestTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.RequestTimeout)
+		defer cancel()
+	}
+
 	// Create a POST request to login.cgi
 	req, err := http.NewRequestWithContext(ctx, "POST", loginURL, strings.NewReader(formData.Encode()))
 	if err != nil {
@@ -129,15 +334,18 @@ func (c *HRUIClient) Login(ctx context.Context) error {
 	// Check for HTTP errors in the response
 	if resp.StatusCode != http.StatusOK {
 		body, _ := io.ReadAll(resp.Body) // for debugging
+		if resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden {
+			return fmt.Errorf("%w: status code %d from login.cgi: %s", ErrAuth, resp.StatusCode, string(body))
+		}
 		return fmt.Errorf("unexpected status code %d from login.cgi: %s", resp.StatusCode, string(body))
 	}
 
-	return c.ValidateAuthCookie(ctx)
+	return nil
 }
 
 // ValidateAuthCookie checks whether the authentication was successful.
 func (c *HRUIClient) ValidateAuthCookie(ctx context.Context) error {
-	authURL := fmt.Sprintf("%s/login.cgi", c.URL)
+	authURL := fmt.Sprintf("%s/login.cgi", c.BaseURL())
 
 	// Execute the GET request using Request
 	responseBody, err := c.Request(ctx, "GET", authURL, nil, nil)
@@ -145,27 +353,129 @@ func (c *HRUIClient) ValidateAuthCookie(ctx context.Context) error {
 		return fmt.Errorf("authentication validation request failed: %w", err)
 	}
 
-	// Parse the response body for validation
-	doc, err := goquery.NewDocumentFromReader(strings.NewReader(string(responseBody)))
-	if err != nil {
-		return fmt.Errorf("error parsing response body with goquery: %w", err)
-	}
-
 	// Check for redirection to the login page in a <script> tag
-	scriptContent := ""
-	doc.Find("script").Each(func(i int, s *goquery.Selection) {
-		scriptContent = s.Text()
-	})
-
-	if strings.Contains(scriptContent, `window.top.location.replace("/login.cgi")`) {
-		return fmt.Errorf("authentication failed: redirected to login page\n\n%s", string(responseBody))
+	if isLoginRedirect(responseBody) {
+		return fmt.Errorf("%w: redirected to login page\n\n%s", ErrAuth, string(responseBody))
 	}
 
 	return nil
 }
 
 // Request handles all HTTP methods and returns the response body as a byte slice.
+// If the device answers with a redirect to the login page, the session is assumed to have
+// expired: the client logs in again and replays the request once.
+// GET responses are cached for PageCacheTTL; any other method flushes the cache.
+// GET requests are retried according to RetryPolicy.
 func (c *HRUIClient) Request(ctx context.Context, method, endpoint string, body io.Reader, headers map[string]string) ([]byte, error) {
+	return c.send(ctx, method, endpoint, body, headers, method == "GET")
+}
+
+// send implements Request. Transient failures are only retried if retry is set.
+func (c *HRUIClient) send(ctx context.Context, method, endpoint string, body io.Reader, headers map[string]string, retry bool) ([]byte, error) {
+	cacheable := method == "GET" && c.PageCacheTTL > 0 && !isLoginEndpoint(endpoint)
+	var generation uint64
+	if cacheable {
+		if cached, ok := c.cache.get(ctx, endpoint); ok {
+			return cached, nil
+		}
+		generation = c.cache.currentGeneration()
+	} else if method != "GET" {
+		// The device state may change even if the request fails halfway.
+		defer c.cache.flush(ctx)
+	}
+
+	respBody, err := c.request(ctx, method, endpoint, body, headers, retry)
+	if err != nil {
+		return nil, err
+	}
+
+	if cacheable {
+		c.cache.put(endpoint, respBody, c.PageCacheTTL, generation)
+	}
+
+	return respBody, nil
+}
+
+// request sends the request, re-authenticating and replaying it once if the session expired.
+func (c *HRUIClient) request(ctx context.Context, method, endpoint string, body io.Reader, headers map[string]string, retry bool) ([]byte, error) {
+	ctx, release := c.shareSession(ctx)
+	defer release()
+
+	// Buffer the body so the request can be replayed after a re-login.
+	var payload []byte
+	if body != nil {
+		var err error
+		payload, err = io.ReadAll(body)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read request body: %w", err)
+		}
+	}
+
+	respBody, err := c.attempt(ctx, method, endpoint, payload, headers, retry)
+	if err != nil {
+		return nil, err
+	}
+
+	// The login page itself is validated by ValidateAuthCookie.
+	if !isLoginRedirect(respBody) || isLoginEndpoint(endpoint) {
+		return respBody, nil
+	}
+
+	tflog.Info(ctx, "HRUI session expired, re-authenticating", map[string]any{"method": method, "endpoint": endpoint})
+
+	if err := c.Login(ctx); err != nil {
+		return nil, fmt.Errorf("%w: re-authentication failed: %w", ErrSessionExpired, err)
+	}
+
+	respBody, err = c.attempt(ctx, method, endpoint, payload, headers, retry)
+	if err != nil {
+		return nil, err
+	}
+
+	if isLoginRedirect(respBody) {
+		return nil, fmt.Errorf("%w: %s request to %s redirected to login page after re-authentication", ErrSessionExpired, method, endpoint)
+	}
+
+	return respBody, nil
+}
+
+// attempt executes the request, retrying transient failures according to RetryPolicy if retry is set.
+func (c *HRUIClient) attempt(ctx context.Context, method, endpoint string, payload []byte, headers map[string]string, retry bool) ([]byte, error) {
+	if !retry {
+		return c.do(ctx, method, endpoint, payload, headers)
+	}
+
+	var respBody []byte
+	err := c.withRetry(ctx, method+" "+endpoint, func() error {
+		var err error
+		respBody, err = c.do(ctx, method, endpoint, payload, headers)
+		return err
+	})
+
+	return respBody, err
+}
+
+// do executes a single HTTP request and returns the response body.
+func (c *HRUIClient) do(ctx context.Context, method, endpoint string, payload []byte, headers map[string]string) ([]byte, error) {
+	var body io.Reader
+	if payload != nil {
+		body = bytes.NewReader(payload)
+	}
+
+	// Wait for a free slot so the device only sees as many requests as it can handle
+	release, err := c.acquire(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to schedule %s request to %s: %w", method, endpoint, err)
+	}
+	defer release()
+
+	// Start the timeout once the request is actually sent, so time spent queueing does not count
+	if timeout := c.requestTimeout(ctx); timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
 	req, err := http.NewRequestWithContext(ctx, method, endpoint, body)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create request: %w", err)
@@ -200,47 +510,107 @@ func (c *HRUIClient) Request(ctx context.Context, method, endpoint string, body
 
 	// Handle non-2xx status codes
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return nil, fmt.Errorf("HTTP request to %s returned status %d: %s", endpoint, resp.StatusCode, string(respBody))
+		return nil, &httpStatusError{Endpoint: endpoint, StatusCode: resp.StatusCode, Body: string(respBody)}
 	}
 
 	return respBody, nil
 }
 
+// isLoginRedirect reports whether a response body is the device's redirect to the login page.
+func isLoginRedirect(body []byte) bool {
+	return bytes.Contains(body, []byte(loginRedirectScript))
+}
+
+// pagePath returns the path of endpoint, e.g. "/vlan.cgi", for use in error values.
+func pagePath(endpoint string) string {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return endpoint
+	}
+	return u.Path
+}
+
+// isLoginEndpoint reports whether the endpoint points at the login page.
+func isLoginEndpoint(endpoint string) bool {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return false
+	}
+	return strings.TrimSuffix(u.Path, "/") == "/login.cgi"
+}
+
 // FormRequest simplifies form submissions via POST and returns the response body as a byte slice.
+// Form submissions are not retried, as sending them twice may not be safe.
 func (c *HRUIClient) FormRequest(ctx context.Context, endpoint string, formData url.Values) ([]byte, error) {
+	return c.formRequest(ctx, endpoint, formData, false)
+}
+
+// idempotentFormRequest is FormRequest for forms that set absolute values, so sending them
+// again after a transient failure is safe. These are retried according to RetryPolicy.
+func (c *HRUIClient) idempotentFormRequest(ctx context.Context, endpoint string, formData url.Values) ([]byte, error) {
+	return c.formRequest(ctx, endpoint, formData, true)
+}
+
+// formRequest implements FormRequest and idempotentFormRequest.
+func (c *HRUIClient) formRequest(ctx context.Context, endpoint string, formData url.Values, idempotent bool) ([]byte, error) {
 	formEncoded := formData.Encode()
 	headers := map[string]string{
 		"Content-Type": "application/x-www-form-urlencoded",
 	}
 
-	// Send the POST request using the Request function
-	respBody, err := c.Request(ctx, "POST", endpoint, strings.NewReader(formEncoded), headers)
+	// Send the POST request
+	respBody, err := c.send(ctx, "POST", endpoint, strings.NewReader(formEncoded), headers, idempotent)
 	if err != nil {
 		return nil, err
 	}
 
-	// Check for error dialogs in the HTML response
+	if err := checkDeviceAlert(endpoint, respBody); err != nil {
+		return nil, err
+	}
+
+	if err := c.saveChanges(ctx); err != nil {
+		return nil, fmt.Errorf("form request succeeded, but saving configuration failed: %w", err)
+	}
+
+	return respBody, nil
+}
+
+// checkDeviceAlert returns a DeviceAlertError if the response of a form submission is an error dialog.
+func checkDeviceAlert(endpoint string, respBody []byte) error {
 	responseStr := string(respBody)
-	if strings.Contains(responseStr, "alert.cgi?alertmsg=") {
-		re := regexp.MustCompile(`alertmsg=([^']+)`)
-		match := re.FindStringSubmatch(responseStr)
-		if len(match) > 1 {
-			alertMsg, _ := url.QueryUnescape(match[1])
-			return nil, fmt.Errorf("device reported an error: %s", alertMsg)
-		}
+	if !strings.Contains(responseStr, "alert.cgi?alertmsg=") {
+		return nil
+	}
 
-		// Generic error fallback (in case the extraction fails)
-		return nil, fmt.Errorf("device reported an unknown error")
+	re := regexp.MustCompile(`alertmsg=([^']+)`)
+	match := re.FindStringSubmatch(responseStr)
+	if len(match) > 1 {
+		alertMsg, _ := url.QueryUnescape(match[1])
+		return &DeviceAlertError{Message: alertMsg, Page: pagePath(endpoint)}
 	}
 
-	// If Autosave is enabled, save the configuration after the form request
-	if c.Autosave {
-		if err := c.CommitChanges(ctx); err != nil {
-			return nil, fmt.Errorf("form request succeeded, but saving configuration failed: %w", err)
-		}
+	// Generic error fallback (in case the extraction fails)
+	return &DeviceAlertError{Page: pagePath(endpoint)}
+}
+
+// pageText returns the visible text of an HTML page with whitespace collapsed, for error messages.
+func pageText(respBody []byte) string {
+	doc, err := goquery.NewDocumentFromReader(bytes.NewReader(respBody))
+	if err != nil {
+		return ""
 	}
+	return strings.Join(strings.Fields(doc.Find("body").Text()), " ")
+}
 
-	return respBody, nil
+// saveChanges saves the configuration after a change if Autosave is enabled,
+// or defers the save until CommitPendingChanges in batch mode.
+func (c *HRUIClient) saveChanges(ctx context.Context) error {
+	if c.Batching() {
+		c.setPending(true)
+	} else if c.Autosave {
+		return c.CommitChanges(ctx)
+	}
+	return nil
 }
 
 // CommitChanges saves the configuration by making a POST request to `/save.cgi`.
@@ -253,38 +623,77 @@ func (c *HRUIClient) CommitChanges(ctx context.Context) error {
 		return fmt.Errorf("HttpClient is nil in HRUIClient")
 	}
 
-	url := fmt.Sprintf("%s/save.cgi", c.URL)
+	url := fmt.Sprintf("%s/save.cgi", c.BaseURL())
 	headers := map[string]string{
 		"Content-Type": "application/x-www-form-urlencoded",
 	}
 
-	const maxRetries = 3
-	const retryDelay = 2 * time.Second
-
-	var lastError error
-	for attempt := 1; attempt <= maxRetries; attempt++ {
-		tflog.Debug(ctx, "Saving configuration", map[string]any{"attempt": attempt})
-
-		// Execute the POST request using Request
-		respBody, err := c.Request(ctx, "POST", url, strings.NewReader("cmd=save"), headers)
-		if err == nil {
-			// Check if the body contains an error message (e.g., for a 500 status code)
-			if strings.Contains(string(respBody), "Error saving configuration") {
-				lastError = fmt.Errorf("failed to save HRUI configuration: %s", string(respBody))
-			} else {
-				// Save succeeded
-				return nil
-			}
-		} else {
-			lastError = fmt.Errorf("failed to save HRUI configuration: %w", err)
+	// Saving is idempotent, so transient failures are retried
+	attempts := 0
+	err := c.withRetry(ctx, "save configuration", func() error {
+		attempts++
+		tflog.Debug(ctx, "Saving configuration", map[string]any{"attempt": attempts})
+
+		respBody, err := c.send(ctx, "POST", url, strings.NewReader("cmd=save"), headers, false)
+		if err != nil {
+			return fmt.Errorf("failed to save HRUI configuration: %w", err)
 		}
 
-		// If not the last attempt, wait before retrying
-		if attempt < maxRetries {
-			time.Sleep(retryDelay)
+		// Check if the body contains an error message
+		if strings.Contains(string(respBody), "Error saving configuration") {
+			return &transientError{err: fmt.Errorf("failed to save HRUI configuration: %s", string(respBody))}
 		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("save configuration failed after %d attempts: %w", attempts, err)
+	}
+
+	// Save succeeded, nothing is pending anymore
+	c.setPending(false)
+	return nil
+}
+
+// CommitPendingChanges saves the configuration only if changes were recorded since the last save.
+// It is used with AutosaveBatch to write all changes of an apply to flash at once.
+func (c *HRUIClient) CommitPendingChanges(ctx context.Context) error {
+	if !c.HasPendingChanges() {
+		tflog.Debug(ctx, "No pending configuration changes to save")
+		return nil
 	}
 
-	// Return the last error after exhausting all retries
-	return fmt.Errorf("save configuration failed after %d retries: %w", maxRetries, lastError)
+	return c.CommitChanges(ctx)
+}
+
+// Batching reports whether saves are deferred until CommitPendingChanges, that is whether
+// Autosave is enabled in AutosaveBatch mode and EndBatch was not called.
+func (c *HRUIClient) Batching() bool {
+	c.pendingMu.Lock()
+	defer c.pendingMu.Unlock()
+	return c.Autosave && c.AutosaveMode == AutosaveBatch && !c.batchEnded
+}
+
+// EndBatch saves the pending changes and makes every later change save immediately, for when
+// nothing is left to call CommitPendingChanges, such as while the resources are destroyed.
+func (c *HRUIClient) EndBatch(ctx context.Context) error {
+	c.pendingMu.Lock()
+	c.batchEnded = true
+	c.pendingMu.Unlock()
+
+	return c.CommitPendingChanges(ctx)
+}
+
+// HasPendingChanges reports whether configuration changes were made but not saved yet.
+func (c *HRUIClient) HasPendingChanges() bool {
+	c.pendingMu.Lock()
+	defer c.pendingMu.Unlock()
+	return c.pending
+}
+
+// setPending records whether there are unsaved configuration changes.
+func (c *HRUIClient) setPending(pending bool) {
+	c.pendingMu.Lock()
+	defer c.pendingMu.Unlock()
+	c.pending = pending
 }
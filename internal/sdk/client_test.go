@@ -230,3 +230,155 @@ func TestClient_FormRequest_Failure(t *testing.T) {
 	require.Error(t, err)
 	require.Contains(t, err.Error(), "/test returned status 404")
 }
+
+// sessionServer simulates a device whose session expires after the initial login.
+// Requests to /test are redirected to the login page while the session is expired.
+func sessionServer(t *testing.T, allowRelogin bool) (*httptest.Server, *int, *url.Values) {
+	logins := 0
+	expired := false
+	received := &url.Values{}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/login.cgi":
+			if r.Method == "POST" {
+				logins++
+				if logins > 1 && !allowRelogin {
+					w.WriteHeader(http.StatusUnauthorized)
+					return
+				}
+				// The session expires right after the initial login.
+				expired = logins == 1
+			}
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte("Login successful"))
+		case "/test":
+			if expired {
+				w.WriteHeader(http.StatusOK)
+				_, _ = w.Write([]byte(`<script type="text/javascript">window.top.location.replace("/login.cgi");</script>`))
+				return
+			}
+			require.NoError(t, r.ParseForm())
+			*received = r.PostForm
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte("Success"))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+
+	return server, &logins, received
+}
+
+func TestClient_Request_ReauthenticatesOnLoginRedirect(t *testing.T) {
+	server, logins, _ := sessionServer(t, true)
+	defer server.Close()
+
+	clientObj := createAuthenticatedClient(t, server)
+	respBody, err := clientObj.Request(context.Background(), "GET", fmt.Sprintf("%s/test", server.URL), nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, "Success", string(respBody))
+	require.Equal(t, 2, *logins)
+}
+
+func TestClient_FormRequest_ReplaysAfterLoginRedirect(t *testing.T) {
+	server, logins, received := sessionServer(t, true)
+	defer server.Close()
+
+	clientObj := createAuthenticatedClient(t, server)
+	formData := url.Values{}
+	formData.Set("param1", "value1")
+
+	respBody, err := clientObj.FormRequest(context.Background(), fmt.Sprintf("%s/test", server.URL), formData)
+	require.NoError(t, err)
+	require.Equal(t, "Success", string(respBody))
+	require.Equal(t, 2, *logins)
+	require.Equal(t, "value1", received.Get("param1"))
+}
+
+func TestClient_Request_SessionExpiredWhenReloginFails(t *testing.T) {
+	server, _, _ := sessionServer(t, false)
+	defer server.Close()
+
+	clientObj := createAuthenticatedClient(t, server)
+	_, err := clientObj.Request(context.Background(), "GET", fmt.Sprintf("%s/test", server.URL), nil, nil)
+	require.Error(t, err)
+	require.ErrorIs(t, err, ErrSessionExpired)
+}
+
+func TestClient_FormRequest_BatchAutosave(t *testing.T) {
+	saves := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/login.cgi", "/test":
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte("Success"))
+		case "/save.cgi":
+			saves++
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte("Configuration saved"))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	clientObj, err := NewClient(context.Background(), server.URL, "testuser", "testpass", true, nil, WithAutosaveMode(AutosaveBatch))
+	require.NoError(t, err)
+	require.False(t, clientObj.HasPendingChanges())
+
+	for range 3 {
+		_, err := clientObj.FormRequest(context.Background(), fmt.Sprintf("%s/test", server.URL), url.Values{"param1": {"value1"}})
+		require.NoError(t, err)
+	}
+	require.Equal(t, 0, saves)
+	require.True(t, clientObj.HasPendingChanges())
+
+	require.NoError(t, clientObj.CommitPendingChanges(context.Background()))
+	require.Equal(t, 1, saves)
+	require.False(t, clientObj.HasPendingChanges())
+
+	// Nothing changed since the last save, so no additional write is issued.
+	require.NoError(t, clientObj.CommitPendingChanges(context.Background()))
+	require.Equal(t, 1, saves)
+
+	// Once the batch ended, pending changes are saved and later changes are saved immediately.
+	_, err = clientObj.FormRequest(context.Background(), fmt.Sprintf("%s/test", server.URL), url.Values{"param1": {"value1"}})
+	require.NoError(t, err)
+	require.NoError(t, clientObj.EndBatch(context.Background()))
+	require.Equal(t, 2, saves)
+	require.False(t, clientObj.Batching())
+
+	_, err = clientObj.FormRequest(context.Background(), fmt.Sprintf("%s/test", server.URL), url.Values{"param1": {"value1"}})
+	require.NoError(t, err)
+	require.Equal(t, 3, saves)
+	require.False(t, clientObj.HasPendingChanges())
+}
+
+func TestClient_FormRequest_ImmediateAutosave(t *testing.T) {
+	saves := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/login.cgi", "/test":
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte("Success"))
+		case "/save.cgi":
+			saves++
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte("Configuration saved"))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	clientObj, err := NewClient(context.Background(), server.URL, "testuser", "testpass", true, nil)
+	require.NoError(t, err)
+
+	for range 2 {
+		_, err := clientObj.FormRequest(context.Background(), fmt.Sprintf("%s/test", server.URL), url.Values{"param1": {"value1"}})
+		require.NoError(t, err)
+	}
+	require.Equal(t, 2, saves)
+	require.False(t, clientObj.HasPendingChanges())
+}
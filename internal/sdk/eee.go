@@ -13,7 +13,7 @@ import (
 // Returns `true` if EEE is enabled, `false` if disabled.
 func (c *HRUIClient) GetEEE(ctx context.Context) (bool, error) {
 	// Issue a GET request to `/eee.cgi`
-	endpoint := fmt.Sprintf("%s/eee.cgi", c.URL)
+	endpoint := fmt.Sprintf("%s/eee.cgi", c.BaseURL())
 	responseBody, err := c.Request(ctx, "GET", endpoint, nil, nil)
 	if err != nil {
 		return false, fmt.Errorf("failed to fetch EEE status: %w", err)
@@ -36,8 +36,8 @@ func (c *HRUIClient) SetEEE(ctx context.Context, enabled bool) error {
 	formData.Set("cmd", "loop") // Required field per the HTML form
 
 	// Issue a POST request to `/eee.cgi`
-	endpoint := fmt.Sprintf("%s/eee.cgi", c.URL)
-	_, err := c.FormRequest(ctx, endpoint, formData)
+	endpoint := fmt.Sprintf("%s/eee.cgi", c.BaseURL())
+	_, err := c.idempotentFormRequest(ctx, endpoint, formData)
 	if err != nil {
 		return fmt.Errorf("failed to update EEE status: %w", err)
 	}
@@ -0,0 +1,55 @@
+package sdk
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	// ErrNotFound is returned when the requested object does not exist on the device.
+	ErrNotFound = errors.New("not found")
+
+	// ErrAuth is returned when the device rejects the configured credentials.
+	ErrAuth = errors.New("authentication failed")
+
+	// ErrSessionExpired is returned when the device redirects a request to the login page
+	// and re-authenticating does not restore the session. It wraps ErrAuth.
+	ErrSessionExpired = fmt.Errorf("%w: HRUI session expired", ErrAuth)
+
+	// ErrUnsupportedFeature is returned when the detected firmware does not support a requested feature.
+	ErrUnsupportedFeature = errors.New("feature not supported by device firmware")
+
+	// ErrDHCPAddressUnknown is returned by ChangeIPAddressSettings when DHCP is enabled without
+	// the address the switch is expected to get, so the client cannot follow the switch.
+	ErrDHCPAddressUnknown = errors.New("the address the switch gets from DHCP is not known")
+)
+
+// DeviceAlertError is returned when the device rejects a form submission with an alert dialog.
+type DeviceAlertError struct {
+	// Message is the alert text shown by the web UI; empty if it could not be extracted.
+	Message string
+	// Page is the path of the page the form was submitted to.
+	Page string
+}
+
+func (e *DeviceAlertError) Error() string {
+	if e.Message == "" {
+		return "device reported an unknown error"
+	}
+	return "device reported an error: " + e.Message
+}
+
+// ParseError is returned when a page does not have the layout the parser expects.
+type ParseError struct {
+	// Page is the page that was parsed.
+	Page string
+	// Selector is the CSS selector that did not match.
+	Selector string
+}
+
+func (e *ParseError) Error() string {
+	if e.Page == "" {
+		return fmt.Sprintf("missing value for selector: %s", e.Selector)
+	}
+	return fmt.Sprintf("unexpected layout of %s: missing value for selector: %s", e.Page, e.Selector)
+}
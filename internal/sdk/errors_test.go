@@ -0,0 +1,66 @@
+package sdk
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestErrors_DeviceAlert(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`<script>window.location.href='alert.cgi?alertmsg=VLAN%20ID%20is%20invalid'</script>`))
+	}))
+	defer server.Close()
+
+	client := &HRUIClient{URL: server.URL, HttpClient: server.Client()}
+	_, err := client.FormRequest(context.Background(), server.URL+"/vlan.cgi?page=static", url.Values{})
+
+	var alertErr *DeviceAlertError
+	require.ErrorAs(t, err, &alertErr)
+	require.Equal(t, "VLAN ID is invalid", alertErr.Message)
+	require.Equal(t, "/vlan.cgi", alertErr.Page)
+	require.EqualError(t, err, "device reported an error: VLAN ID is invalid")
+}
+
+func TestErrors_NotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`<form name="formVlanStatus"><table><tr><th>VLAN</th></tr></table></form>`))
+	}))
+	defer server.Close()
+
+	client := &HRUIClient{URL: server.URL, HttpClient: server.Client()}
+	_, err := client.GetVLAN(context.Background(), 42)
+	require.ErrorIs(t, err, ErrNotFound)
+	require.EqualError(t, err, "VLAN with ID 42 not found")
+}
+
+func TestErrors_Auth(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer server.Close()
+
+	_, err := NewClient(context.Background(), server.URL, "user", "wrong", false, nil)
+	require.ErrorIs(t, err, ErrAuth)
+
+	require.ErrorIs(t, ErrSessionExpired, ErrAuth)
+}
+
+func TestErrors_Parse(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`<html><body></body></html>`))
+	}))
+	defer server.Close()
+
+	client := &HRUIClient{URL: server.URL, HttpClient: server.Client()}
+	_, err := client.GetTotalPorts(context.Background())
+
+	var parseErr *ParseError
+	require.ErrorAs(t, err, &parseErr)
+	require.Equal(t, "/trunk.cgi?page=group", parseErr.Page)
+	require.Equal(t, "select#portsel option", parseErr.Selector)
+}
@@ -0,0 +1,157 @@
+package sdk
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"mime/multipart"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// DefaultFirmwareRebootTimeout is how long UpgradeFirmware waits for the switch to come back.
+	DefaultFirmwareRebootTimeout = DefaultReadyTimeout
+
+	// firmwareImageFileName is used when the upgrade does not name the image file.
+	firmwareImageFileName = "firmware.bin"
+)
+
+// FirmwareUpgrade describes a firmware image to install.
+type FirmwareUpgrade struct {
+	// Image is the content of the firmware image file.
+	Image []byte
+
+	// FileName is the file name sent with the image. Defaults to firmware.bin.
+	FileName string
+
+	// TargetVersion is the firmware version the switch must report after the upgrade, e.g. "V1.9.1".
+	TargetVersion string
+
+	// RebootTimeout bounds the wait for the switch to come back. Defaults to DefaultFirmwareRebootTimeout.
+	RebootTimeout time.Duration
+
+	// PollInterval is the delay between two checks of info.cgi. Defaults to 5 seconds.
+	PollInterval time.Duration
+}
+
+// FirmwareVersionMatches reports whether two firmware versions are the same, ignoring
+// case, surrounding whitespace and a leading "v".
+func FirmwareVersionMatches(a, b string) bool {
+	return normalizeFirmwareVersion(a) == normalizeFirmwareVersion(b)
+}
+
+// UpgradeFirmware uploads a firmware image, waits for the switch to reboot and verifies that
+// it reports the target version. The image is not uploaded if the switch already runs the
+// target version. It returns the system information read once the switch is back.
+func (c *HRUIClient) UpgradeFirmware(ctx context.Context, upgrade *FirmwareUpgrade) (*SystemInfo, error) {
+	if len(upgrade.Image) == 0 {
+		return nil, errors.New("firmware image is empty")
+	}
+	if upgrade.TargetVersion == "" {
+		return nil, errors.New("target firmware version is required")
+	}
+
+	info, err := c.GetSystemInfo(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read current firmware version: %w", err)
+	}
+	if FirmwareVersionMatches(info.FirmwareVersion, upgrade.TargetVersion) {
+		tflog.Info(ctx, "Switch already runs the target firmware, skipping upgrade", map[string]any{
+			"firmware_version": info.FirmwareVersion,
+		})
+		return info, nil
+	}
+
+	tflog.Info(ctx, "Uploading firmware image", map[string]any{
+		"current_version": info.FirmwareVersion,
+		"target_version":  upgrade.TargetVersion,
+		"size":            len(upgrade.Image),
+	})
+
+	if err := c.uploadFirmware(ctx, upgrade); err != nil {
+		return nil, err
+	}
+
+	info, err = c.waitForFirmware(ctx, upgrade)
+	if err != nil {
+		return nil, err
+	}
+
+	caps := capabilitiesFor(info.FirmwareVersion, info.HardwareVersion)
+	c.setCapabilities(caps)
+
+	tflog.Info(ctx, "Firmware upgraded", map[string]any{"firmware_version": info.FirmwareVersion})
+	return info, nil
+}
+
+// uploadFirmware posts the image to the upgrade page of the web UI.
+func (c *HRUIClient) uploadFirmware(ctx context.Context, upgrade *FirmwareUpgrade) error {
+	fileName := upgrade.FileName
+	if fileName == "" {
+		fileName = firmwareImageFileName
+	}
+
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+	if err := writer.WriteField("cmd", "upgrade"); err != nil {
+		return fmt.Errorf("failed to build firmware upload request: %w", err)
+	}
+	part, err := writer.CreateFormFile("file", fileName)
+	if err != nil {
+		return fmt.Errorf("failed to build firmware upload request: %w", err)
+	}
+	if _, err := part.Write(upgrade.Image); err != nil {
+		return fmt.Errorf("failed to build firmware upload request: %w", err)
+	}
+	if err := writer.Close(); err != nil {
+		return fmt.Errorf("failed to build firmware upload request: %w", err)
+	}
+
+	// Uploads are not retried: the device may already be flashing the first one.
+	endpoint := fmt.Sprintf("%s/upgrade.cgi", c.BaseURL())
+	headers := map[string]string{
+		"Content-Type": writer.FormDataContentType(),
+	}
+	respBody, err := c.send(ctx, "POST", endpoint, &body, headers, false)
+	if err != nil {
+		return fmt.Errorf("failed to upload firmware image: %w", err)
+	}
+	if err := checkDeviceAlert(endpoint, respBody); err != nil {
+		return fmt.Errorf("failed to upload firmware image: %w", err)
+	}
+
+	return nil
+}
+
+// waitForFirmware polls info.cgi until the switch reports the target version, logging in again
+// before each poll as the reboot ends the session.
+func (c *HRUIClient) waitForFirmware(ctx context.Context, upgrade *FirmwareUpgrade) (*SystemInfo, error) {
+	timeout := upgrade.RebootTimeout
+	if timeout <= 0 {
+		timeout = DefaultFirmwareRebootTimeout
+	}
+
+	var info *SystemInfo
+	err := c.waitUntil(ctx, timeout, upgrade.PollInterval, func(ctx context.Context) error {
+		if err := c.Login(ctx); err != nil {
+			return err
+		}
+		current, err := c.GetSystemInfo(ctx)
+		if err != nil {
+			return err
+		}
+		if !FirmwareVersionMatches(current.FirmwareVersion, upgrade.TargetVersion) {
+			return fmt.Errorf("switch reports version %s, expected %s", current.FirmwareVersion, upgrade.TargetVersion)
+		}
+		info = current
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("firmware upgrade failed: %w", err)
+	}
+
+	return info, nil
+}
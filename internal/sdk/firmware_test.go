@@ -0,0 +1,149 @@
+package sdk
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// firmwareServer emulates an upgrade: after an image is uploaded to upgrade.cgi the switch
+// is unavailable for downPolls requests, then reports newVersion on info.cgi.
+type firmwareServer struct {
+	*httptest.Server
+
+	mu         sync.Mutex
+	version    string
+	newVersion string
+	downPolls  int
+	uploads    [][]byte
+	logins     int
+}
+
+func newFirmwareServer(t *testing.T, version, newVersion string, downPolls int) *firmwareServer {
+	fs := &firmwareServer{version: version, newVersion: newVersion}
+	fs.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fs.mu.Lock()
+		defer fs.mu.Unlock()
+
+		if fs.downPolls > 0 {
+			fs.downPolls--
+			if fs.downPolls == 0 {
+				fs.version = fs.newVersion
+			}
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+
+		switch r.URL.Path {
+		case "/login.cgi":
+			if r.Method == http.MethodPost {
+				fs.logins++
+			}
+		case "/upgrade.cgi":
+			assert.Equal(t, http.MethodPost, r.Method)
+			require.NoError(t, r.ParseMultipartForm(1<<20))
+			assert.Equal(t, "upgrade", r.FormValue("cmd"))
+			file, _, err := r.FormFile("file")
+			require.NoError(t, err)
+			image, err := io.ReadAll(file)
+			require.NoError(t, err)
+			fs.uploads = append(fs.uploads, image)
+			fs.downPolls = downPolls
+			if downPolls == 0 {
+				fs.version = fs.newVersion
+			}
+		case "/info.cgi":
+			_, _ = w.Write([]byte(`<table>
+				<tr><th>Firmware Version</th><td>` + fs.version + `</td></tr>
+				<tr><th>Hardware Version</th><td>V1.0</td></tr>
+			</table>`))
+		}
+	}))
+	return fs
+}
+
+func newFirmwareClient(t *testing.T, url string) *HRUIClient {
+	client, err := NewClient(context.Background(), url, "admin", "secret", false, nil,
+		WithFirmwareDetection(false), WithRetryPolicy(RetryPolicy{MaxAttempts: 1}))
+	require.NoError(t, err)
+	return client
+}
+
+func TestUpgradeFirmware(t *testing.T) {
+	server := newFirmwareServer(t, "V1.9", "V1.9.1", 3)
+	defer server.Close()
+	client := newFirmwareClient(t, server.URL)
+
+	info, err := client.UpgradeFirmware(context.Background(), &FirmwareUpgrade{
+		Image:         []byte("image"),
+		TargetVersion: "1.9.1",
+		PollInterval:  time.Millisecond,
+		RebootTimeout: 5 * time.Second,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "V1.9.1", info.FirmwareVersion)
+	assert.Equal(t, [][]byte{[]byte("image")}, server.uploads)
+	assert.Greater(t, server.logins, 1, "the client should log in again after the reboot")
+	assert.Equal(t, "V1.9.1", client.Capabilities().FirmwareVersion)
+}
+
+func TestUpgradeFirmware_AlreadyAtTarget(t *testing.T) {
+	server := newFirmwareServer(t, "V1.9.1", "V1.9.1", 0)
+	defer server.Close()
+	client := newFirmwareClient(t, server.URL)
+
+	info, err := client.UpgradeFirmware(context.Background(), &FirmwareUpgrade{
+		Image:         []byte("image"),
+		TargetVersion: "v1.9.1",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "V1.9.1", info.FirmwareVersion)
+	assert.Empty(t, server.uploads, "the image should not be uploaded again")
+}
+
+func TestUpgradeFirmware_VersionMismatch(t *testing.T) {
+	server := newFirmwareServer(t, "V1.9", "V1.9", 1)
+	defer server.Close()
+	client := newFirmwareClient(t, server.URL)
+
+	_, err := client.UpgradeFirmware(context.Background(), &FirmwareUpgrade{
+		Image:         []byte("image"),
+		TargetVersion: "V1.9.1",
+		PollInterval:  time.Millisecond,
+		RebootTimeout: 200 * time.Millisecond,
+	})
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "switch reports version V1.9, expected V1.9.1")
+}
+
+func TestUpgradeFirmware_DeviceAlert(t *testing.T) {
+	server := mockServerMock(`<script>window.location.href='alert.cgi?alertmsg=Invalid%20firmware%20image'</script>`, http.StatusOK)
+	defer server.Close()
+
+	client := &HRUIClient{URL: server.URL, HttpClient: server.Client()}
+	err := client.uploadFirmware(context.Background(), &FirmwareUpgrade{Image: []byte("image"), TargetVersion: "V1.9.1"})
+
+	var alert *DeviceAlertError
+	require.ErrorAs(t, err, &alert)
+	assert.Equal(t, "Invalid firmware image", alert.Message)
+}
+
+func TestUpgradeFirmware_InvalidInput(t *testing.T) {
+	client := &HRUIClient{URL: "http://127.0.0.1:0"}
+
+	_, err := client.UpgradeFirmware(context.Background(), &FirmwareUpgrade{TargetVersion: "V1.9.1"})
+	assert.Error(t, err)
+
+	_, err = client.UpgradeFirmware(context.Background(), &FirmwareUpgrade{Image: []byte("image")})
+	assert.Error(t, err)
+}
@@ -46,7 +46,7 @@ type jumboOption struct {
 func extractJumboFrameOptions(doc *goquery.Document) ([]jumboOption, error) {
 	optionsSel := doc.Find("select[name='jumboframe'] option")
 	if optionsSel.Length() == 0 {
-		return nil, errors.New("unable to find jumbo frame options")
+		return nil, &ParseError{Page: "/fwd.cgi?page=jumboframe", Selector: "select[name='jumboframe'] option"}
 	}
 
 	var options []jumboOption
@@ -131,7 +131,7 @@ func parseSelectedJumboFrameSize(doc *goquery.Document) (int, error) {
 
 // GetStormControlStatus fetches the current storm control status from the HTML page.
 func (c *HRUIClient) GetStormControlStatus(ctx context.Context) (*StormControlConfig, error) {
-	respBody, err := c.Request(ctx, "GET", c.URL+"/fwd.cgi?page=storm_ctrl", nil, nil)
+	respBody, err := c.Request(ctx, "GET", c.BaseURL()+"/fwd.cgi?page=storm_ctrl", nil, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch storm control page: %w", err)
 	}
@@ -142,6 +142,17 @@ func (c *HRUIClient) GetStormControlStatus(ctx context.Context) (*StormControlCo
 		return nil, errors.New("failed to parse HTML response")
 	}
 
+	entries, err := c.parsers().stormControl.parse(doc)
+	if err != nil {
+		return nil, err
+	}
+
+	// Return the parsed results
+	return &StormControlConfig{Entries: entries}, nil
+}
+
+// parseStormControlTable extracts the per-port storm control rates from the last table of the storm control page.
+func parseStormControlTable(doc *goquery.Document) ([]StormControlEntry, error) {
 	// Define parse options for rates
 	parseRateOptions := func() []ParseOption {
 		return []ParseOption{
@@ -186,8 +197,7 @@ func (c *HRUIClient) GetStormControlStatus(ctx context.Context) (*StormControlCo
 		entries = append(entries, entry)
 	})
 
-	// Return the parsed results
-	return &StormControlConfig{Entries: entries}, nil
+	return entries, nil
 }
 
 // SetStormControlConfig updates the storm control settings for specific ports.
@@ -222,7 +232,7 @@ func (c *HRUIClient) SetStormControlConfig(
 		formData.Set("rate", strconv.FormatInt(*rate, 10))
 	}
 
-	respBody, err := c.FormRequest(ctx, c.URL+"/fwd.cgi?page=storm_ctrl", formData)
+	respBody, err := c.idempotentFormRequest(ctx, c.BaseURL()+"/fwd.cgi?page=storm_ctrl", formData)
 	if err != nil {
 		return fmt.Errorf("failed to update storm control settings: %w", err)
 	}
@@ -251,7 +261,7 @@ func (c *HRUIClient) SetStormControlConfig(
 // using the provided human-readable port name (e.g., "Port 1") rather than port ID.
 func (c *HRUIClient) GetPortMaxRate(ctx context.Context, portName string) (int64, error) {
 	// Fetch the storm control HTML page.
-	respBody, err := c.Request(ctx, "GET", c.URL+"/fwd.cgi?page=storm_ctrl", nil, nil)
+	respBody, err := c.Request(ctx, "GET", c.BaseURL()+"/fwd.cgi?page=storm_ctrl", nil, nil)
 	if err != nil {
 		return 0, fmt.Errorf("failed to fetch storm control page: %w", err)
 	}
@@ -302,7 +312,7 @@ func findRateText(doc *goquery.Document, portName string) (string, error) {
 
 	// If the rate text was not found, return an error.
 	if !found {
-		return "", fmt.Errorf("rate information not found for port '%s'", portName)
+		return "", fmt.Errorf("rate information %w for port '%s'", ErrNotFound, portName)
 	}
 
 	return rateText, nil
@@ -351,7 +361,7 @@ func stormTypeToID(stormType string) string {
 // GetJumboFrame retrieves the current Jumbo Frame configuration from the HTML page.
 func (c *HRUIClient) GetJumboFrame(ctx context.Context) (*JumboFrame, error) {
 	// URL to the Jumbo Frame page
-	urlJumbo := fmt.Sprintf("%s/fwd.cgi?page=jumboframe", c.URL)
+	urlJumbo := fmt.Sprintf("%s/fwd.cgi?page=jumboframe", c.BaseURL())
 
 	// Perform HTTP GET request
 	respBody, err := c.Request(ctx, "GET", urlJumbo, nil, nil)
@@ -386,6 +396,21 @@ func (c *HRUIClient) SetJumboFrame(ctx context.Context, frameSize int) (int, err
 		return 0, fmt.Errorf("invalid Jumbo Frame size '%d': supported sizes are 1522, 1536, 1552, 9216, 16383", frameSize)
 	}
 
+	caps := c.Capabilities()
+	if frameSize == 16383 {
+		if err := c.requireCapability(caps.JumboFrame16383, "jumbo frame size 16383"); err != nil {
+			return 0, err
+		}
+	}
+
+	// Use the option values of the detected firmware when they are known.
+	if val, ok := caps.jumboFrameOptions[frameSize]; ok {
+		if err := c.submitJumboFrame(ctx, val, frameSize); err != nil {
+			return 0, fmt.Errorf("failed to apply jumbo frame size: %w", err)
+		}
+		return frameSize, nil
+	}
+
 	legacyMapA := map[int]string{
 		1522:  "0",
 		1536:  "1",
@@ -438,10 +463,10 @@ func (c *HRUIClient) submitJumboFrame(ctx context.Context, optionValue string, f
 	formData.Set("jumboframe", optionValue)
 
 	// URL of the Jumbo Frame page
-	endpoint := fmt.Sprintf("%s/fwd.cgi?page=jumboframe", c.URL)
+	endpoint := fmt.Sprintf("%s/fwd.cgi?page=jumboframe", c.BaseURL())
 
 	// Send the POST request
-	respBody, err := c.FormRequest(ctx, endpoint, formData)
+	respBody, err := c.idempotentFormRequest(ctx, endpoint, formData)
 	if err != nil {
 		return fmt.Errorf("failed to set Jumbo Frame value '%s': %w", optionValue, err)
 	}
@@ -473,7 +498,7 @@ func (c *HRUIClient) submitJumboFrame(ctx context.Context, optionValue string, f
 // Firmware versions may change the option value indexes, so we inspect the current page to determine
 // the correct value to post back.
 func (c *HRUIClient) resolveJumboFrameOptionValue(ctx context.Context, frameSize int) (string, error) {
-	urlJumbo := fmt.Sprintf("%s/fwd.cgi?page=jumboframe", c.URL)
+	urlJumbo := fmt.Sprintf("%s/fwd.cgi?page=jumboframe", c.BaseURL())
 	respBody, err := c.Request(ctx, "GET", urlJumbo, nil, nil)
 	if err != nil {
 		return "", fmt.Errorf("failed to fetch Jumbo Frame page: %w", err)
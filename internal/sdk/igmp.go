@@ -5,7 +5,6 @@ import (
 	"fmt"
 	"net/url"
 	"strings"
-	"sync"
 
 	"github.com/PuerkitoBio/goquery"
 )
@@ -16,9 +15,6 @@ type IGMPConfig struct {
 	Ports   map[int]bool
 }
 
-// Global lock to serialize IGMP port updates.
-var igmpUpdateLock sync.Mutex
-
 // ConfigureIGMPSnooping enables or disables IGMP snooping globally.
 func (c *HRUIClient) ConfigureIGMPSnooping(ctx context.Context, enable bool) error {
 	return c.updateGlobalIGMP(ctx, enable)
@@ -40,9 +36,9 @@ func (c *HRUIClient) updateGlobalIGMP(ctx context.Context, enable bool) error {
 	if enable {
 		formData.Set("enable_igmp", "on")
 	}
-	url := fmt.Sprintf("%s/igmp.cgi?page=enable_igmp", c.URL)
+	url := fmt.Sprintf("%s/igmp.cgi?page=enable_igmp", c.BaseURL())
 
-	if _, err := c.FormRequest(ctx, url, formData); err != nil {
+	if _, err := c.idempotentFormRequest(ctx, url, formData); err != nil {
 		return fmt.Errorf("failed to update global IGMP snooping: %w", err)
 	}
 	return nil
@@ -50,9 +46,13 @@ func (c *HRUIClient) updateGlobalIGMP(ctx context.Context, enable bool) error {
 
 // ConfigurePortIGMPSnooping enables or disables IGMP snooping for a specific port.
 func (c *HRUIClient) ConfigurePortIGMPSnooping(ctx context.Context, portID int, enable bool) error {
-	igmpUpdateLock.Lock()
-	defer igmpUpdateLock.Unlock()
+	return c.exclusive(ctx, func(ctx context.Context) error {
+		return c.configurePortIGMPSnooping(ctx, portID, enable)
+	})
+}
 
+// configurePortIGMPSnooping implements ConfigurePortIGMPSnooping while holding the operation lock.
+func (c *HRUIClient) configurePortIGMPSnooping(ctx context.Context, portID int, enable bool) error {
 	currentState, err := c.GetAllPortsIGMPSnooping(ctx)
 	if err != nil {
 		return fmt.Errorf("failed to fetch IGMP configuration: %w", err)
@@ -90,8 +90,8 @@ func (c *HRUIClient) ConfigurePortIGMPSnooping(ctx context.Context, portID int,
 	payload.Add("cmd", "set")
 
 	// Send the configuration update to the IGMP settings endpoint.
-	url := fmt.Sprintf("%s/igmp.cgi?page=igmp_static_router", c.URL)
-	if _, err := c.FormRequest(ctx, url, payload); err != nil {
+	url := fmt.Sprintf("%s/igmp.cgi?page=igmp_static_router", c.BaseURL())
+	if _, err := c.idempotentFormRequest(ctx, url, payload); err != nil {
 		return fmt.Errorf("failed to update IGMP snooping for port %d: %w", portID, err)
 	}
 
@@ -100,12 +100,18 @@ func (c *HRUIClient) ConfigurePortIGMPSnooping(ctx context.Context, portID int,
 
 // GetAllPortsIGMPSnooping retrieves the current IGMP snooping configuration for all ports.
 func (c *HRUIClient) GetAllPortsIGMPSnooping(ctx context.Context) (map[int]string, error) {
-	respBody, err := c.Request(ctx, "GET", c.URL+"/igmp.cgi?page=dump", nil, nil)
+	respBody, err := c.Request(ctx, "GET", c.BaseURL()+"/igmp.cgi?page=dump", nil, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch IGMP port statuses: %w", err)
 	}
 
-	portStates, err := parseAllPortsIGMPStatus(respBody)
+	// Parse the HTML using goquery.
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(string(respBody)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse IGMP status response: %w", err)
+	}
+
+	portStates, err := c.parsers().igmpPorts.parse(doc)
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse IGMP port statuses: %w", err)
 	}
@@ -156,7 +162,7 @@ func (c *HRUIClient) GetPortIGMPSnoopingByName(ctx context.Context, portName str
 
 // FetchIGMPConfig fetches and parses the complete IGMP configuration.
 func (c *HRUIClient) FetchIGMPConfig(ctx context.Context) (*IGMPConfig, error) {
-	url := fmt.Sprintf("%s/igmp.cgi?page=dump", c.URL)
+	url := fmt.Sprintf("%s/igmp.cgi?page=dump", c.BaseURL())
 
 	respBody, err := c.Request(ctx, "GET", url, nil, nil)
 	if err != nil {
@@ -198,14 +204,8 @@ func (c *HRUIClient) FetchIGMPConfig(ctx context.Context) (*IGMPConfig, error) {
 	}, nil
 }
 
-// parseAllPortsIGMPStatus parses the IGMP snooping states for all ports from the response body.
-func parseAllPortsIGMPStatus(respBody []byte) (map[int]string, error) {
-	// Parse the HTML using goquery.
-	doc, err := goquery.NewDocumentFromReader(strings.NewReader(string(respBody)))
-	if err != nil {
-		return nil, fmt.Errorf("failed to parse IGMP status response: %w", err)
-	}
-
+// parseAllPortsIGMPStatus parses the IGMP snooping states for all ports from the "static" rows of the IGMP page.
+func parseAllPortsIGMPStatus(doc *goquery.Document) (map[int]string, error) {
 	// Port states to return.
 	portStates := make(map[int]string)
 
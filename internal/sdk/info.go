@@ -8,9 +8,47 @@ import (
 	"github.com/PuerkitoBio/goquery"
 )
 
+// SystemInfo represents the information shown on the System Info page.
+type SystemInfo struct {
+	DeviceModel string
+	// MACAddress is normalized to upper case with colon separators, e.g. "1C:2A:A3:23:D1:BA".
+	MACAddress      string
+	IPAddress       string
+	Netmask         string
+	Gateway         string
+	FirmwareVersion string
+	FirmwareDate    string
+	HardwareVersion string
+	// SerialNumber and Uptime are empty on firmware that does not show them.
+	SerialNumber string
+	Uptime       string
+
+	// Fields holds every label/value pair of the page, keyed by the normalized label.
+	Fields map[string]string
+}
+
+// systemInfoLabels maps the labels used by the firmware, in lower case, to the SystemInfo fields.
+var systemInfoLabels = map[string]func(info *SystemInfo) *string{
+	"device model":     func(info *SystemInfo) *string { return &info.DeviceModel },
+	"model":            func(info *SystemInfo) *string { return &info.DeviceModel },
+	"mac address":      func(info *SystemInfo) *string { return &info.MACAddress },
+	"ip address":       func(info *SystemInfo) *string { return &info.IPAddress },
+	"netmask":          func(info *SystemInfo) *string { return &info.Netmask },
+	"subnet mask":      func(info *SystemInfo) *string { return &info.Netmask },
+	"gateway":          func(info *SystemInfo) *string { return &info.Gateway },
+	"firmware version": func(info *SystemInfo) *string { return &info.FirmwareVersion },
+	"firmware date":    func(info *SystemInfo) *string { return &info.FirmwareDate },
+	"hardware version": func(info *SystemInfo) *string { return &info.HardwareVersion },
+	"serial number":    func(info *SystemInfo) *string { return &info.SerialNumber },
+	"serial no.":       func(info *SystemInfo) *string { return &info.SerialNumber },
+	"uptime":           func(info *SystemInfo) *string { return &info.Uptime },
+	"system uptime":    func(info *SystemInfo) *string { return &info.Uptime },
+	"up time":          func(info *SystemInfo) *string { return &info.Uptime },
+}
+
 // GetSystemInfo retrieves system information from the HRUI server.
-func (c *HRUIClient) GetSystemInfo(ctx context.Context) (map[string]string, error) {
-	systemInfoURL := fmt.Sprintf("%s/info.cgi", c.URL)
+func (c *HRUIClient) GetSystemInfo(ctx context.Context) (*SystemInfo, error) {
+	systemInfoURL := fmt.Sprintf("%s/info.cgi", c.BaseURL())
 
 	respBody, err := c.Request(ctx, "GET", systemInfoURL, nil, nil)
 	if err != nil {
@@ -22,12 +60,45 @@ func (c *HRUIClient) GetSystemInfo(ctx context.Context) (map[string]string, erro
 		return nil, fmt.Errorf("failed to parse System Info HTML output: %w", err)
 	}
 
-	systemInfo := make(map[string]string)
+	return c.parsers().systemInfo.parse(doc)
+}
+
+// parseSystemInfo parses the label/value rows of info.cgi. Labels are either <th> cells
+// followed by a <td>, or a pair of <td> cells.
+func parseSystemInfo(doc *goquery.Document) (*SystemInfo, error) {
+	info := &SystemInfo{Fields: make(map[string]string)}
+
 	doc.Find("table tr").Each(func(i int, s *goquery.Selection) {
-		key := s.Find("th").Text()
-		value := s.Find("td").Text()
-		systemInfo[key] = value
+		var label, value string
+		if th := s.Find("th"); th.Length() > 0 {
+			label, value = th.First().Text(), s.Find("td").First().Text()
+		} else if td := s.Find("td"); td.Length() >= 2 {
+			label, value = td.Eq(0).Text(), td.Eq(1).Text()
+		}
+
+		label = strings.TrimSuffix(normalizeSpace(label), ":")
+		if label == "" {
+			return
+		}
+		value = normalizeSpace(value)
+
+		info.Fields[label] = value
+		if field, ok := systemInfoLabels[strings.ToLower(label)]; ok {
+			*field(info) = value
+		}
 	})
 
-	return systemInfo, nil
+	info.MACAddress = normalizeMACAddress(info.MACAddress)
+
+	return info, nil
+}
+
+// normalizeSpace trims s and collapses runs of whitespace, including non-breaking spaces, into one space.
+func normalizeSpace(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
+
+// normalizeMACAddress formats a MAC address as upper case with colon separators.
+func normalizeMACAddress(mac string) string {
+	return strings.ToUpper(strings.ReplaceAll(mac, "-", ":"))
 }
@@ -13,19 +13,62 @@ func TestGetSystemInfo(t *testing.T) {
 	tests := []struct {
 		name       string
 		html       string
-		expected   map[string]string
+		expected   *SystemInfo
 		shouldFail bool
 		httpStatus int
 	}{
 		{
 			"Valid system info",
 			`<table>
-				<tr><th>OS</th><td>Linux</td></tr>
-				<tr><th>Version</th><td>1.2.3</td></tr>
+				<tr><th style="width:150px;">Device Model</th><td style="width:250px;">ZX-SWTG124AS</td></tr>
+				<tr><th>MAC Address</th><td>1c-2a-a3-23-d1-ba</td></tr>
+				<tr><th>IP Address</th><td>192.168.178.30</td></tr>
+				<tr><th>Netmask</th><td>255.255.255.0</td></tr>
+				<tr><th>Gateway</th><td>192.168.178.1</td></tr>
+				<tr><th>Firmware Version</th><td>V1.9.1</td></tr>
+				<tr><th>Firmware Date</th><td>Mar 18 2024</td></tr>
+				<tr><th>Hardware Version</th><td>V1.0</td></tr>
 			</table>`,
-			map[string]string{
-				"OS":      "Linux",
-				"Version": "1.2.3",
+			&SystemInfo{
+				DeviceModel:     "ZX-SWTG124AS",
+				MACAddress:      "1C:2A:A3:23:D1:BA",
+				IPAddress:       "192.168.178.30",
+				Netmask:         "255.255.255.0",
+				Gateway:         "192.168.178.1",
+				FirmwareVersion: "V1.9.1",
+				FirmwareDate:    "Mar 18 2024",
+				HardwareVersion: "V1.0",
+				Fields: map[string]string{
+					"Device Model":     "ZX-SWTG124AS",
+					"MAC Address":      "1c-2a-a3-23-d1-ba",
+					"IP Address":       "192.168.178.30",
+					"Netmask":          "255.255.255.0",
+					"Gateway":          "192.168.178.1",
+					"Firmware Version": "V1.9.1",
+					"Firmware Date":    "Mar 18 2024",
+					"Hardware Version": "V1.0",
+				},
+			},
+			false,
+			http.StatusOK,
+		},
+		{
+			"Untrimmed labels, cell pairs and optional fields",
+			`<table>
+				<tr><td>  Model: </td><td>
+					SWTG118AS  </td></tr>
+				<tr><th>Serial&nbsp;Number</th><td> SN123 </td></tr>
+				<tr><th>System   Uptime</th><td>3 days,  04:05:06</td></tr>
+			</table>`,
+			&SystemInfo{
+				DeviceModel:  "SWTG118AS",
+				SerialNumber: "SN123",
+				Uptime:       "3 days, 04:05:06",
+				Fields: map[string]string{
+					"Model":         "SWTG118AS",
+					"Serial Number": "SN123",
+					"System Uptime": "3 days, 04:05:06",
+				},
 			},
 			false,
 			http.StatusOK,
@@ -33,7 +76,7 @@ func TestGetSystemInfo(t *testing.T) {
 		{
 			"Empty table",
 			`<table></table>`,
-			map[string]string{},
+			&SystemInfo{Fields: map[string]string{}},
 			false,
 			http.StatusOK,
 		},
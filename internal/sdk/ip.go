@@ -3,11 +3,15 @@ package sdk
 import (
 	"context"
 	"fmt"
+	"net"
+	"net/netip"
 	"net/url"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/PuerkitoBio/goquery"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 // IPAddressSettings represents the IP configuration.
@@ -21,7 +25,7 @@ type IPAddressSettings struct {
 // GetIPAddressSettings retrieves the IP address settings from the HRUI server.
 func (c *HRUIClient) GetIPAddressSettings(ctx context.Context) (*IPAddressSettings, error) {
 	// Construct the IP settings URL
-	ipSettingsURL := fmt.Sprintf("%s/ip.cgi", c.URL)
+	ipSettingsURL := fmt.Sprintf("%s/ip.cgi", c.BaseURL())
 
 	// Execute GET request to fetch IP settings
 	respBody, err := c.Request(ctx, "GET", ipSettingsURL, nil, nil)
@@ -61,7 +65,84 @@ func (c *HRUIClient) GetIPAddressSettings(ctx context.Context) (*IPAddressSettin
 }
 
 // SetIPAddressSettings updates the IP address settings on the HRUI server.
+// Requests made afterwards fail if the switch moves to another address, see ChangeIPAddressSettings.
 func (c *HRUIClient) SetIPAddressSettings(ctx context.Context, settings *IPAddressSettings) error {
+	if err := settings.Validate(); err != nil {
+		return err
+	}
+
+	ipSettingsURL := fmt.Sprintf("%s/ip.cgi", c.BaseURL())
+	_, err := c.FormRequest(ctx, ipSettingsURL, ipAddressForm(settings))
+	if err != nil {
+		return fmt.Errorf("failed to update IP Settings: %w", err)
+	}
+
+	return nil
+}
+
+// ChangeIPAddressSettings updates the IP address settings and follows the switch if its address
+// changes: the client is pointed at the new address, logs in again once the switch answers there,
+// and only then saves the configuration if Autosave is enabled. It fails if the switch cannot be
+// reached within timeout; a zero timeout or interval selects DefaultReadyTimeout or 5 seconds.
+//
+// When DHCP gets enabled, dhcpAddress is the address the switch is expected to get, such as the
+// address of a DHCP reservation. Without it ChangeIPAddressSettings returns ErrDHCPAddressUnknown
+// before changing anything. If the address does not change, or FollowAddressChanges is disabled,
+// ChangeIPAddressSettings behaves like SetIPAddressSettings and dhcpAddress is not used.
+func (c *HRUIClient) ChangeIPAddressSettings(ctx context.Context, settings *IPAddressSettings, dhcpAddress string, timeout, interval time.Duration) error {
+	if err := settings.Validate(); err != nil {
+		return err
+	}
+
+	if !c.FollowAddressChanges {
+		return c.SetIPAddressSettings(ctx, settings)
+	}
+
+	current, err := c.GetIPAddressSettings(ctx)
+	if err != nil {
+		return err
+	}
+
+	moved := settings.DHCPEnabled != current.DHCPEnabled || (!settings.DHCPEnabled && settings.IPAddress != current.IPAddress)
+	if !moved {
+		return c.SetIPAddressSettings(ctx, settings)
+	}
+
+	address := settings.IPAddress
+	if settings.DHCPEnabled {
+		if dhcpAddress == "" {
+			return fmt.Errorf("cannot follow the switch after enabling DHCP: %w", ErrDHCPAddressUnknown)
+		}
+		if _, err := parseIPv4Address(dhcpAddress); err != nil {
+			return fmt.Errorf("invalid expected DHCP address: %w", err)
+		}
+		address = dhcpAddress
+	}
+	newURL, err := replaceHost(c.BaseURL(), address)
+	if err != nil {
+		return err
+	}
+
+	tflog.Info(ctx, "Changing the switch address", map[string]any{"from": c.BaseURL(), "to": newURL})
+	if err := c.postDisconnecting(ctx, "ip.cgi", ipAddressForm(settings)); err != nil {
+		return fmt.Errorf("failed to update IP Settings: %w", err)
+	}
+
+	c.setBaseURL(newURL)
+	if err := c.WaitForReady(ctx, timeout, interval); err != nil {
+		return fmt.Errorf("IP settings were changed, but the switch is not reachable at %s: %w", newURL, err)
+	}
+
+	// The change is only saved once the switch is known to be reachable at its new address.
+	if err := c.saveChanges(ctx); err != nil {
+		return fmt.Errorf("IP settings were changed, but saving configuration failed: %w", err)
+	}
+
+	return nil
+}
+
+// ipAddressForm returns the form of the IP address settings page.
+func ipAddressForm(settings *IPAddressSettings) url.Values {
 	form := url.Values{}
 	form.Set("dhcp_state", "0")
 	if settings.DHCPEnabled {
@@ -70,12 +151,135 @@ func (c *HRUIClient) SetIPAddressSettings(ctx context.Context, settings *IPAddre
 	form.Set("ip", settings.IPAddress)
 	form.Set("netmask", settings.Netmask)
 	form.Set("gateway", settings.Gateway)
+	return form
+}
 
-	ipSettingsURL := fmt.Sprintf("%s/ip.cgi", c.URL)
-	_, err := c.FormRequest(ctx, ipSettingsURL, form)
+// replaceHost returns baseURL with its host replaced by address, keeping the scheme and port.
+func replaceHost(baseURL, address string) (string, error) {
+	u, err := url.Parse(baseURL)
 	if err != nil {
-		return fmt.Errorf("failed to update IP Settings: %w", err)
+		return "", fmt.Errorf("error parsing URL: %w", err)
+	}
+
+	if port := u.Port(); port != "" {
+		u.Host = net.JoinHostPort(address, port)
+	} else {
+		u.Host = address
+	}
+	return u.String(), nil
+}
+
+// Validate checks that static settings describe a usable host: IPAddress and Netmask must be
+// valid, IPAddress must not be the network or broadcast address of its subnet, and a Gateway,
+// if any, must be another host of that subnet. Settings with DHCP enabled are not checked.
+func (s *IPAddressSettings) Validate() error {
+	if s.DHCPEnabled {
+		return nil
 	}
 
+	addr, err := parseIPv4Address(s.IPAddress)
+	if err != nil {
+		return err
+	}
+	bits, err := NetmaskBits(s.Netmask)
+	if err != nil {
+		return err
+	}
+	subnet := netip.PrefixFrom(addr, bits).Masked()
+	if err := checkHostAddress(subnet, addr); err != nil {
+		return fmt.Errorf("IP address %w", err)
+	}
+
+	if s.Gateway == "" {
+		return nil
+	}
+	gateway, err := parseIPv4Address(s.Gateway)
+	if err != nil {
+		return err
+	}
+	if !subnet.Contains(gateway) {
+		return fmt.Errorf("gateway %q is not in the subnet %s of the switch", s.Gateway, subnet)
+	}
+	if err := checkHostAddress(subnet, gateway); err != nil {
+		return fmt.Errorf("gateway %w", err)
+	}
+	if gateway == addr {
+		return fmt.Errorf("gateway %q must differ from the switch address", s.Gateway)
+	}
+	return nil
+}
+
+// SplitCIDR splits an IPv4 address in CIDR notation, such as 192.168.1.10/24, into the
+// address and the dotted netmask IPAddressSettings expects.
+func SplitCIDR(cidr string) (ipAddress, netmask string, err error) {
+	prefix, err := netip.ParsePrefix(cidr)
+	if err != nil {
+		return "", "", fmt.Errorf("invalid CIDR address %q: %w", cidr, err)
+	}
+	if !prefix.Addr().Is4() {
+		return "", "", fmt.Errorf("%q is not an IPv4 CIDR address", cidr)
+	}
+	if prefix.Bits() == 0 {
+		return "", "", fmt.Errorf("invalid prefix length in %q", cidr)
+	}
+	return prefix.Addr().String(), net.IP(net.CIDRMask(prefix.Bits(), 32)).String(), nil
+}
+
+// JoinCIDR is the reverse of SplitCIDR.
+func JoinCIDR(ipAddress, netmask string) (string, error) {
+	addr, err := parseIPv4Address(ipAddress)
+	if err != nil {
+		return "", err
+	}
+	bits, err := NetmaskBits(netmask)
+	if err != nil {
+		return "", err
+	}
+	return netip.PrefixFrom(addr, bits).String(), nil
+}
+
+// NetmaskBits returns the prefix length of a dotted IPv4 netmask. It fails if the set bits of
+// the netmask are not contiguous.
+func NetmaskBits(netmask string) (int, error) {
+	addr, err := netip.ParseAddr(netmask)
+	if err != nil || !addr.Is4() {
+		return 0, fmt.Errorf("invalid netmask %q", netmask)
+	}
+	ones, bits := net.IPMask(addr.AsSlice()).Size()
+	if bits == 0 || ones == 0 {
+		return 0, fmt.Errorf("invalid netmask %q: the set bits must be contiguous", netmask)
+	}
+	return ones, nil
+}
+
+// parseIPv4Address parses an IPv4 address in dotted notation.
+func parseIPv4Address(s string) (netip.Addr, error) {
+	addr, err := netip.ParseAddr(s)
+	if err != nil {
+		return netip.Addr{}, fmt.Errorf("invalid IP address %q: %w", s, err)
+	}
+	if !addr.Is4() {
+		return netip.Addr{}, fmt.Errorf("%q is not an IPv4 address", s)
+	}
+	return addr, nil
+}
+
+// checkHostAddress returns an error if addr is the network or broadcast address of subnet.
+// Point-to-point subnets (/31 and /32) have neither.
+func checkHostAddress(subnet netip.Prefix, addr netip.Addr) error {
+	if subnet.Bits() >= 31 {
+		return nil
+	}
+	if addr == subnet.Addr() {
+		return fmt.Errorf("%q is the network address of %s", addr, subnet)
+	}
+	mask := net.CIDRMask(subnet.Bits(), 32)
+	broadcast := subnet.Addr().As4()
+	for i := range broadcast {
+		broadcast[i] |= ^mask[i]
+	}
+	if addr == netip.AddrFrom4(broadcast) {
+		return fmt.Errorf("%q is the broadcast address of %s", addr, subnet)
+	}
 	return nil
 }
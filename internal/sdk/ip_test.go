@@ -6,9 +6,13 @@ import (
 	"net/http/httptest"
 	"net/url"
 	"strconv"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestGetIPAddressSettings(t *testing.T) {
@@ -137,6 +141,137 @@ func TestSetIPAddressSettings(t *testing.T) {
 	}
 }
 
+const ipAddressSettingsHTML = `<select name='dhcp_state'>
+	<option value='0' selected>Static</option>
+	<option value='1'>DHCP</option>
+</select>
+<input name='ip' value='192.168.1.100'>
+<input name='netmask' value='255.255.255.0'>
+<input name='gateway' value='192.168.1.1'>`
+
+// newIPChangeServer emulates a switch whose IP settings page reports 192.168.1.100 and records
+// the posted pages together with the host they were sent to.
+func newIPChangeServer(t *testing.T) (*httptest.Server, *[]string) {
+	var mu sync.Mutex
+	var posts []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			mu.Lock()
+			posts = append(posts, r.Host+r.URL.Path)
+			mu.Unlock()
+			return
+		}
+		if r.URL.Path == "/ip.cgi" {
+			_, _ = w.Write([]byte(ipAddressSettingsHTML))
+		}
+	}))
+	return server, &posts
+}
+
+func TestChangeIPAddressSettings(t *testing.T) {
+	server, posts := newIPChangeServer(t)
+	defer server.Close()
+
+	// The switch is managed through a name and moves to 127.0.0.1, which reaches the same server.
+	oldURL := strings.Replace(server.URL, "127.0.0.1", "localhost", 1)
+	newURL := server.URL
+	oldHost, newHost := strings.TrimPrefix(oldURL, "http://"), strings.TrimPrefix(newURL, "http://")
+
+	client := newRebootClient(t, oldURL)
+	*posts = nil
+
+	err := client.ChangeIPAddressSettings(context.Background(),
+		&IPAddressSettings{false, "127.0.0.1", "255.0.0.0", ""}, "", time.Second, 10*time.Millisecond)
+
+	require.NoError(t, err)
+	assert.Equal(t, newURL, client.BaseURL())
+	assert.Equal(t, []string{oldHost + "/ip.cgi", newHost + "/login.cgi", newHost + "/save.cgi"}, *posts,
+		"the change is saved at the new address once the switch answers there")
+}
+
+func TestChangeIPAddressSettings_ConcurrentRequests(t *testing.T) {
+	server, _ := newIPChangeServer(t)
+	defer server.Close()
+
+	oldURL := strings.Replace(server.URL, "127.0.0.1", "localhost", 1)
+	client := newRebootClient(t, oldURL)
+
+	// Run with -race: other resources keep reading the device while the client moves.
+	done := make(chan struct{})
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for {
+			select {
+			case <-done:
+				return
+			default:
+				_, _ = client.GetIPAddressSettings(context.Background())
+			}
+		}
+	}()
+
+	err := client.ChangeIPAddressSettings(context.Background(),
+		&IPAddressSettings{false, "127.0.0.1", "255.0.0.0", ""}, "", time.Second, 10*time.Millisecond)
+	close(done)
+	wg.Wait()
+
+	require.NoError(t, err)
+	assert.Equal(t, server.URL, client.BaseURL())
+}
+
+func TestChangeIPAddressSettings_Unreachable(t *testing.T) {
+	server, posts := newIPChangeServer(t)
+	defer server.Close()
+
+	client := newRebootClient(t, server.URL)
+	*posts = nil
+
+	// Nothing listens on 127.0.0.2.
+	err := client.ChangeIPAddressSettings(context.Background(),
+		&IPAddressSettings{false, "127.0.0.2", "255.0.0.0", ""}, "", 200*time.Millisecond, 10*time.Millisecond)
+
+	require.ErrorContains(t, err, "the switch is not reachable at http://127.0.0.2:")
+	assert.Equal(t, []string{strings.TrimPrefix(server.URL, "http://") + "/ip.cgi"}, *posts, "the change is not saved")
+}
+
+func TestChangeIPAddressSettings_DHCP(t *testing.T) {
+	server, posts := newIPChangeServer(t)
+	defer server.Close()
+
+	oldURL := strings.Replace(server.URL, "127.0.0.1", "localhost", 1)
+	client := newRebootClient(t, oldURL)
+	*posts = nil
+
+	// Without the expected address the client cannot follow the switch, so nothing is changed.
+	err := client.ChangeIPAddressSettings(context.Background(), &IPAddressSettings{DHCPEnabled: true}, "", time.Second, 10*time.Millisecond)
+	require.ErrorIs(t, err, ErrDHCPAddressUnknown)
+	assert.Empty(t, *posts)
+	assert.Equal(t, oldURL, client.BaseURL())
+
+	// The switch gets the address reserved for it.
+	err = client.ChangeIPAddressSettings(context.Background(), &IPAddressSettings{DHCPEnabled: true}, "127.0.0.1", time.Second, 10*time.Millisecond)
+	require.NoError(t, err)
+	assert.Equal(t, server.URL, client.BaseURL())
+}
+
+func TestChangeIPAddressSettings_SameAddress(t *testing.T) {
+	server, posts := newIPChangeServer(t)
+	defer server.Close()
+
+	client := newRebootClient(t, server.URL)
+	*posts = nil
+	host := strings.TrimPrefix(server.URL, "http://")
+
+	err := client.ChangeIPAddressSettings(context.Background(),
+		&IPAddressSettings{false, "192.168.1.100", "255.255.255.0", "192.168.1.254"}, "", time.Second, 10*time.Millisecond)
+
+	require.NoError(t, err)
+	assert.Equal(t, server.URL, client.BaseURL())
+	assert.Equal(t, []string{host + "/ip.cgi", host + "/save.cgi"}, *posts)
+}
+
 // Helper function to convert bool to int (1 for true, 0 for false).
 func btoi(b bool) int {
 	if b {
@@ -144,3 +279,53 @@ func btoi(b bool) int {
 	}
 	return 0
 }
+
+func TestIPAddressSettingsValidate(t *testing.T) {
+	tests := []struct {
+		name     string
+		settings IPAddressSettings
+		errMsg   string
+	}{
+		{"Valid", IPAddressSettings{false, "192.168.1.100", "255.255.255.0", "192.168.1.1"}, ""},
+		{"No gateway", IPAddressSettings{false, "10.0.0.5", "255.0.0.0", ""}, ""},
+		{"Point-to-point", IPAddressSettings{false, "10.0.0.0", "255.255.255.254", "10.0.0.1"}, ""},
+		{"DHCP is not checked", IPAddressSettings{true, "", "", ""}, ""},
+		{"Malformed address", IPAddressSettings{false, "192.168.1.300", "255.255.255.0", ""}, `invalid IP address "192.168.1.300"`},
+		{"IPv6 address", IPAddressSettings{false, "2001:db8::1", "255.255.255.0", ""}, `"2001:db8::1" is not an IPv4 address`},
+		{"Malformed netmask", IPAddressSettings{false, "192.168.1.100", "255.255.255", ""}, `invalid netmask "255.255.255"`},
+		{"Non-contiguous netmask", IPAddressSettings{false, "192.168.1.100", "255.0.255.0", ""}, `invalid netmask "255.0.255.0": the set bits must be contiguous`},
+		{"Empty netmask", IPAddressSettings{false, "192.168.1.100", "0.0.0.0", ""}, `invalid netmask "0.0.0.0"`},
+		{"Network address", IPAddressSettings{false, "192.168.1.0", "255.255.255.0", ""}, `IP address "192.168.1.0" is the network address of 192.168.1.0/24`},
+		{"Broadcast address", IPAddressSettings{false, "192.168.1.255", "255.255.255.0", ""}, `IP address "192.168.1.255" is the broadcast address of 192.168.1.0/24`},
+		{"Gateway outside subnet", IPAddressSettings{false, "192.168.1.100", "255.255.255.0", "192.168.2.1"}, `gateway "192.168.2.1" is not in the subnet 192.168.1.0/24 of the switch`},
+		{"Broadcast gateway", IPAddressSettings{false, "192.168.0.100", "255.255.254.0", "192.168.1.255"}, `gateway "192.168.1.255" is the broadcast address of 192.168.0.0/23`},
+		{"Gateway is the switch", IPAddressSettings{false, "192.168.1.100", "255.255.255.0", "192.168.1.100"}, `gateway "192.168.1.100" must differ from the switch address`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.settings.Validate()
+			if tt.errMsg == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorContains(t, err, tt.errMsg)
+		})
+	}
+}
+
+func TestSplitCIDR(t *testing.T) {
+	ipAddress, netmask, err := SplitCIDR("192.168.1.100/23")
+	require.NoError(t, err)
+	assert.Equal(t, "192.168.1.100", ipAddress)
+	assert.Equal(t, "255.255.254.0", netmask)
+
+	cidr, err := JoinCIDR(ipAddress, netmask)
+	require.NoError(t, err)
+	assert.Equal(t, "192.168.1.100/23", cidr)
+
+	for _, invalid := range []string{"192.168.1.100", "192.168.1.100/33", "2001:db8::1/64", "192.168.1.100/0"} {
+		_, _, err := SplitCIDR(invalid)
+		assert.Error(t, err, invalid)
+	}
+}
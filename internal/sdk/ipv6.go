@@ -0,0 +1,179 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+// IPv6Settings represents the IPv6 configuration of the management interface.
+type IPv6Settings struct {
+	// Autoconfig enables stateless address autoconfiguration. Address, PrefixLength and Gateway
+	// are then learned from router advertisements and only reported by the switch.
+	Autoconfig   bool
+	Address      string
+	PrefixLength int
+	Gateway      string
+
+	// LinkLocalAddress is derived from the MAC address and cannot be set.
+	LinkLocalAddress string
+}
+
+// ipv6Page is the page of the IPv6 management settings.
+const ipv6Page = "ip.cgi?page=ipv6"
+
+// ipv6Feature names the IPv6 settings in ErrUnsupportedFeature errors.
+const ipv6Feature = "configuring IPv6 management settings"
+
+// GetIPv6Settings retrieves the IPv6 settings of the management interface.
+// It returns ErrUnsupportedFeature if the firmware has no IPv6 settings, see Capabilities.IPv6.
+func (c *HRUIClient) GetIPv6Settings(ctx context.Context) (*IPv6Settings, error) {
+	if err := c.requireCapability(c.Capabilities().IPv6, ipv6Feature); err != nil {
+		return nil, err
+	}
+
+	respBody, err := c.Request(ctx, "GET", fmt.Sprintf("%s/%s", c.BaseURL(), ipv6Page), nil, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch IPv6 settings from HRUI: %w", err)
+	}
+
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(string(respBody)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse IPv6 settings HTML output: %w", err)
+	}
+
+	settings, err := c.parsers().ipv6.parse(doc)
+	if err != nil {
+		return nil, c.optionalPageError(err, c.Capabilities().IPv6, ipv6Feature)
+	}
+	return settings, nil
+}
+
+// SetIPv6Settings updates the IPv6 settings of the management interface. With Autoconfig enabled,
+// the static address, prefix length and gateway are left empty. It returns ErrUnsupportedFeature
+// if the firmware has no IPv6 settings.
+func (c *HRUIClient) SetIPv6Settings(ctx context.Context, settings *IPv6Settings) error {
+	if err := settings.Validate(); err != nil {
+		return err
+	}
+
+	if err := c.requireCapability(c.Capabilities().IPv6, ipv6Feature); err != nil {
+		return err
+	}
+
+	form := url.Values{}
+	if settings.Autoconfig {
+		form.Set("ipv6_autoconf", "1")
+		form.Set("ipv6_addr", "")
+		form.Set("ipv6_prefix", "")
+		form.Set("ipv6_gateway", "")
+	} else {
+		form.Set("ipv6_autoconf", "0")
+		form.Set("ipv6_addr", settings.Address)
+		form.Set("ipv6_prefix", strconv.Itoa(settings.PrefixLength))
+		form.Set("ipv6_gateway", settings.Gateway)
+	}
+
+	_, err := c.idempotentFormRequest(ctx, fmt.Sprintf("%s/%s", c.BaseURL(), ipv6Page), form)
+	if err != nil {
+		return fmt.Errorf("failed to update IPv6 settings: %w", err)
+	}
+
+	return nil
+}
+
+// Validate checks that the settings can be applied. Without Autoconfig, a global unicast
+// Address and a PrefixLength are required. The Gateway is optional and may be link-local;
+// a global gateway must be inside the prefix of Address.
+func (s *IPv6Settings) Validate() error {
+	if s.Autoconfig {
+		return nil
+	}
+
+	if s.Address == "" {
+		return fmt.Errorf("an IPv6 address is required when autoconfiguration is disabled")
+	}
+	addr, err := ParseIPv6Address(s.Address)
+	if err != nil {
+		return err
+	}
+	if !addr.IsGlobalUnicast() {
+		return fmt.Errorf("IPv6 address %q must be a global or unique local unicast address", s.Address)
+	}
+
+	if s.PrefixLength < 1 || s.PrefixLength > 128 {
+		return fmt.Errorf("invalid IPv6 prefix length: %d", s.PrefixLength)
+	}
+
+	if s.Gateway == "" {
+		return nil
+	}
+	gateway, err := ParseIPv6Address(s.Gateway)
+	if err != nil {
+		return err
+	}
+	if !gateway.IsGlobalUnicast() && !gateway.IsLinkLocalUnicast() {
+		return fmt.Errorf("IPv6 gateway %q must be a unicast address", s.Gateway)
+	}
+	if gateway == addr {
+		return fmt.Errorf("IPv6 gateway %q must differ from the switch address", s.Gateway)
+	}
+	if gateway.IsGlobalUnicast() && !netip.PrefixFrom(addr, s.PrefixLength).Masked().Contains(gateway) {
+		return fmt.Errorf("IPv6 gateway %q is not in %s/%d", s.Gateway, s.Address, s.PrefixLength)
+	}
+	return nil
+}
+
+// ParseIPv6Address parses an IPv6 address. IPv4 and IPv4-mapped addresses and addresses with a
+// zone are rejected, the switch does not accept them.
+func ParseIPv6Address(s string) (netip.Addr, error) {
+	addr, err := netip.ParseAddr(s)
+	if err != nil {
+		return netip.Addr{}, fmt.Errorf("invalid IPv6 address %q: %w", s, err)
+	}
+	if !addr.Is6() || addr.Is4In6() {
+		return netip.Addr{}, fmt.Errorf("%q is not an IPv6 address", s)
+	}
+	if addr.Zone() != "" {
+		return netip.Addr{}, fmt.Errorf("IPv6 address %q must not have a zone", s)
+	}
+	return addr, nil
+}
+
+// parseIPv6Settings reads the IPv6 settings form.
+func parseIPv6Settings(doc *goquery.Document) (*IPv6Settings, error) {
+	selector := "input[name='ipv6_addr']"
+	if doc.Find(selector).Length() == 0 {
+		return nil, &ParseError{Page: "/" + ipv6Page, Selector: selector}
+	}
+
+	settings := &IPv6Settings{}
+	doc.Find("select[name='ipv6_autoconf'] option[selected]").Each(func(i int, s *goquery.Selection) {
+		value, _ := s.Attr("value")
+		settings.Autoconfig = value == "1"
+	})
+	settings.Address = inputValue(doc, "ipv6_addr")
+	settings.Gateway = inputValue(doc, "ipv6_gateway")
+	settings.LinkLocalAddress = inputValue(doc, "ipv6_link_local")
+
+	if prefix := inputValue(doc, "ipv6_prefix"); prefix != "" {
+		prefixLength, err := strconv.Atoi(prefix)
+		if err != nil {
+			return nil, fmt.Errorf("invalid IPv6 prefix length %q: %w", prefix, err)
+		}
+		settings.PrefixLength = prefixLength
+	}
+
+	return settings, nil
+}
+
+// inputValue returns the trimmed value of the input named name, or an empty string.
+func inputValue(doc *goquery.Document, name string) string {
+	value, _ := doc.Find(fmt.Sprintf("input[name='%s']", name)).First().Attr("value")
+	return strings.TrimSpace(value)
+}
@@ -0,0 +1,213 @@
+package sdk
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const ipv6SettingsHTML = `<form method="post" action="/ip.cgi?page=ipv6">
+	<table>
+		<tr><th>Auto Configuration</th><td>
+			<select name="ipv6_autoconf">
+				<option value="0" selected>Disable</option>
+				<option value="1">Enable</option>
+			</select>
+		</td></tr>
+		<tr><th>Link-Local Address</th><td><input type="text" name="ipv6_link_local" value="fe80::21a:2bff:fe3c:4d5e" disabled></td></tr>
+		<tr><th>IPv6 Address</th><td><input type="text" name="ipv6_addr" value="2001:db8:10::2"></td></tr>
+		<tr><th>Prefix Length</th><td><input type="text" name="ipv6_prefix" value="64" maxlength="3"></td></tr>
+		<tr><th>Gateway</th><td><input type="text" name="ipv6_gateway" value="fe80::1"></td></tr>
+	</table>
+</form>`
+
+const ipv6AutoconfHTML = `<form method="post" action="/ip.cgi?page=ipv6">
+	<select name="ipv6_autoconf">
+		<option value="0">Disable</option>
+		<option value="1" selected>Enable</option>
+	</select>
+	<input type="text" name="ipv6_link_local" value="fe80::21a:2bff:fe3c:4d5e" disabled>
+	<input type="text" name="ipv6_addr" value="">
+	<input type="text" name="ipv6_prefix" value="">
+	<input type="text" name="ipv6_gateway" value="">
+</form>`
+
+// withIPv6 returns a client for firmware with the IPv6 settings. No recorded firmware has
+// them, so the capability is off by default.
+func withIPv6(server *httptest.Server) *HRUIClient {
+	client := &HRUIClient{URL: server.URL, HttpClient: server.Client()}
+	client.setCapabilities(Capabilities{IPv6: true})
+	return client
+}
+
+func TestGetIPv6Settings(t *testing.T) {
+	tests := []struct {
+		name     string
+		html     string
+		expected *IPv6Settings
+	}{
+		{
+			"Static address",
+			ipv6SettingsHTML,
+			&IPv6Settings{
+				Address:          "2001:db8:10::2",
+				PrefixLength:     64,
+				Gateway:          "fe80::1",
+				LinkLocalAddress: "fe80::21a:2bff:fe3c:4d5e",
+			},
+		},
+		{
+			"Autoconfiguration",
+			ipv6AutoconfHTML,
+			&IPv6Settings{Autoconfig: true, LinkLocalAddress: "fe80::21a:2bff:fe3c:4d5e"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := mockServerMock(tt.html, http.StatusOK)
+			defer server.Close()
+
+			client := withIPv6(server)
+			settings, err := client.GetIPv6Settings(context.Background())
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, settings)
+		})
+	}
+}
+
+func TestGetIPv6Settings_Unsupported(t *testing.T) {
+	requested := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requested = true
+	}))
+	defer server.Close()
+
+	for _, firmware := range []string{"V1.9", "V1.9.1", "V2.0"} {
+		client := &HRUIClient{URL: server.URL, HttpClient: server.Client()}
+		client.setCapabilities(capabilitiesFor(firmware, "V1.0"))
+		_, err := client.GetIPv6Settings(context.Background())
+
+		assert.ErrorIs(t, err, ErrUnsupportedFeature, firmware)
+	}
+	assert.False(t, requested, "no known firmware has the IPv6 settings")
+}
+
+func TestGetIPv6Settings_UnknownFirmwareWithoutSettings(t *testing.T) {
+	// Firmware without IPv6 support serves the plain IP address page.
+	server := mockServerMock(`<form method="post" action="ip.cgi"><input name="ip" value="192.168.1.1"></form>`, http.StatusOK)
+	defer server.Close()
+
+	client := withIPv6(server)
+	_, err := client.GetIPv6Settings(context.Background())
+
+	assert.ErrorIs(t, err, ErrUnsupportedFeature)
+}
+
+func TestGetIPv6Settings_UnexpectedLayout(t *testing.T) {
+	server := mockServerMock(`<form method="post" action="ip.cgi"><input name="ip" value="192.168.1.1"></form>`, http.StatusOK)
+	defer server.Close()
+
+	// Firmware known to have the settings, so a page without them is a layout problem.
+	client := &HRUIClient{URL: server.URL, HttpClient: server.Client()}
+	client.setCapabilities(Capabilities{Known: true, FirmwareVersion: "V2.0", IPv6: true})
+	_, err := client.GetIPv6Settings(context.Background())
+
+	var parseErr *ParseError
+	require.ErrorAs(t, err, &parseErr)
+	assert.NotErrorIs(t, err, ErrUnsupportedFeature)
+}
+
+func TestSetIPv6Settings(t *testing.T) {
+	var receivedForm url.Values
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			require.NoError(t, r.ParseForm())
+			assert.Equal(t, "page=ipv6", r.URL.RawQuery)
+			receivedForm = r.PostForm
+		}
+		_, _ = w.Write([]byte(ipv6SettingsHTML))
+	}))
+	defer server.Close()
+
+	client := withIPv6(server)
+
+	err := client.SetIPv6Settings(context.Background(), &IPv6Settings{
+		Address:      "2001:db8:20::2",
+		PrefixLength: 48,
+		Gateway:      "2001:db8:20::1",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, url.Values{
+		"ipv6_autoconf": {"0"},
+		"ipv6_addr":     {"2001:db8:20::2"},
+		"ipv6_prefix":   {"48"},
+		"ipv6_gateway":  {"2001:db8:20::1"},
+	}, receivedForm)
+
+	err = client.SetIPv6Settings(context.Background(), &IPv6Settings{Autoconfig: true, Address: "2001:db8:20::2"})
+	require.NoError(t, err)
+	assert.Equal(t, url.Values{
+		"ipv6_autoconf": {"1"},
+		"ipv6_addr":     {""},
+		"ipv6_prefix":   {""},
+		"ipv6_gateway":  {""},
+	}, receivedForm, "static settings are cleared with autoconfiguration")
+}
+
+func TestSetIPv6Settings_Unsupported(t *testing.T) {
+	posted := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		posted = posted || r.Method == http.MethodPost
+	}))
+	defer server.Close()
+
+	client := &HRUIClient{URL: server.URL, HttpClient: server.Client()}
+	client.setCapabilities(capabilitiesFor("V1.9", "V1.0"))
+	err := client.SetIPv6Settings(context.Background(), &IPv6Settings{Autoconfig: true})
+
+	assert.ErrorIs(t, err, ErrUnsupportedFeature)
+	assert.False(t, posted, "the settings must not be posted to firmware without them")
+}
+
+func TestIPv6SettingsValidate(t *testing.T) {
+	tests := []struct {
+		name     string
+		settings IPv6Settings
+		errMsg   string
+	}{
+		{"Autoconfiguration", IPv6Settings{Autoconfig: true}, ""},
+		{"Link-local gateway", IPv6Settings{Address: "2001:db8::2", PrefixLength: 64, Gateway: "fe80::1"}, ""},
+		{"Unique local address", IPv6Settings{Address: "fd00::2", PrefixLength: 64, Gateway: "fd00::1"}, ""},
+		{"No gateway", IPv6Settings{Address: "2001:db8::2", PrefixLength: 128}, ""},
+		{"Missing address", IPv6Settings{PrefixLength: 64}, "an IPv6 address is required when autoconfiguration is disabled"},
+		{"Malformed address", IPv6Settings{Address: "2001:db8::g", PrefixLength: 64}, `invalid IPv6 address "2001:db8::g"`},
+		{"IPv4 address", IPv6Settings{Address: "192.168.1.2", PrefixLength: 64}, `"192.168.1.2" is not an IPv6 address`},
+		{"IPv4-mapped address", IPv6Settings{Address: "::ffff:192.168.1.2", PrefixLength: 64}, `"::ffff:192.168.1.2" is not an IPv6 address`},
+		{"Zone", IPv6Settings{Address: "2001:db8::2%eth0", PrefixLength: 64}, `IPv6 address "2001:db8::2%eth0" must not have a zone`},
+		{"Link-local address", IPv6Settings{Address: "fe80::2", PrefixLength: 64}, `IPv6 address "fe80::2" must be a global or unique local unicast address`},
+		{"Multicast address", IPv6Settings{Address: "ff02::1", PrefixLength: 64}, `IPv6 address "ff02::1" must be a global or unique local unicast address`},
+		{"Missing prefix length", IPv6Settings{Address: "2001:db8::2"}, "invalid IPv6 prefix length: 0"},
+		{"Prefix length too long", IPv6Settings{Address: "2001:db8::2", PrefixLength: 129}, "invalid IPv6 prefix length: 129"},
+		{"Multicast gateway", IPv6Settings{Address: "2001:db8::2", PrefixLength: 64, Gateway: "ff02::2"}, `IPv6 gateway "ff02::2" must be a unicast address`},
+		{"Gateway is the switch", IPv6Settings{Address: "2001:db8::2", PrefixLength: 64, Gateway: "2001:db8::2"}, `IPv6 gateway "2001:db8::2" must differ from the switch address`},
+		{"Gateway outside prefix", IPv6Settings{Address: "2001:db8:1::2", PrefixLength: 64, Gateway: "2001:db8:2::1"}, `IPv6 gateway "2001:db8:2::1" is not in 2001:db8:1::2/64`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.settings.Validate()
+			if tt.errMsg == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorContains(t, err, tt.errMsg)
+		})
+	}
+}
@@ -2,6 +2,7 @@ package sdk
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"net/url"
@@ -10,6 +11,7 @@ import (
 	"time"
 
 	"github.com/PuerkitoBio/goquery"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 // LoopFunctionType maps human-readable loop function values (like Off, Loop Detection, etc.)
@@ -70,7 +72,7 @@ type STPPort struct {
 
 // GetLoopProtocol fetches the loop protocol settings.
 func (c *HRUIClient) GetLoopProtocol(ctx context.Context) (*LoopProtocol, error) {
-	loopURL := c.URL + "/loop.cgi"
+	loopURL := c.BaseURL() + "/loop.cgi"
 
 	respBody, err := c.Request(ctx, "GET", loopURL, nil, nil)
 	if err != nil {
@@ -111,7 +113,7 @@ func (c *HRUIClient) GetLoopProtocol(ctx context.Context) (*LoopProtocol, error)
 
 // ConfigureLoopProtocol updates the loop function and associated settings.
 func (c *HRUIClient) ConfigureLoopProtocol(ctx context.Context, loopFunction string, intervalTime, recoverTime int, portStatuses []PortStatus) error {
-	loopURL := c.URL + "/loop.cgi"
+	loopURL := c.BaseURL() + "/loop.cgi"
 	funcType, valid := LoopFunctionType[loopFunction]
 	if !valid {
 		return fmt.Errorf("invalid loop function type: %s", loopFunction)
@@ -125,7 +127,7 @@ func (c *HRUIClient) ConfigureLoopProtocol(ctx context.Context, loopFunction str
 		"recover_time":  []string{strconv.Itoa(recoverTime)},
 	}
 
-	_, err := c.FormRequest(ctx, loopURL, formData)
+	_, err := c.idempotentFormRequest(ctx, loopURL, formData)
 	if err != nil {
 		return fmt.Errorf("failed to update loop protocol: %w", err)
 	}
@@ -135,7 +137,7 @@ func (c *HRUIClient) ConfigureLoopProtocol(ctx context.Context, loopFunction str
 
 // GetSTPSettings fetches and parses the STP Global Settings page.
 func (c *HRUIClient) GetSTPSettings(ctx context.Context) (*STPGlobalSettings, error) {
-	stpURL := c.URL + "/loop.cgi?page=stp_global"
+	stpURL := c.BaseURL() + "/loop.cgi?page=stp_global"
 
 	respBody, err := c.Request(ctx, "GET", stpURL, nil, nil)
 	if err != nil {
@@ -152,7 +154,7 @@ func (c *HRUIClient) GetSTPSettings(ctx context.Context) (*STPGlobalSettings, er
 
 // SetSTPSettings updates the STP global settings.
 func (c *HRUIClient) SetSTPSettings(ctx context.Context, stp *STPGlobalSettings) error {
-	stpURL := c.URL + "/loop.cgi?page=stp_global"
+	stpURL := c.BaseURL() + "/loop.cgi?page=stp_global"
 	formData := url.Values{
 		"cmd":      []string{"stp"},
 		"version":  []string{stp.GetVersionValue()},
@@ -162,7 +164,7 @@ func (c *HRUIClient) SetSTPSettings(ctx context.Context, stp *STPGlobalSettings)
 		"delay":    []string{strconv.Itoa(stp.ForwardDelay)},
 	}
 
-	_, err := c.FormRequest(ctx, stpURL, formData)
+	_, err := c.idempotentFormRequest(ctx, stpURL, formData)
 	if err != nil {
 		return fmt.Errorf("failed to update STP global settings: %w", err)
 	}
@@ -170,10 +172,16 @@ func (c *HRUIClient) SetSTPSettings(ctx context.Context, stp *STPGlobalSettings)
 	return nil
 }
 
+// stpApplyTimeout is how long SetSTPSettingsAsync waits for the answer to the STP global settings POST.
+const stpApplyTimeout = 2 * time.Second
+
 // SetSTPSettingsAsync performs a fire-and-forget POST request to update the STP Global Settings.
 // needed due to a bug in the cgi for updating stp global settings that never returns.
+// The short deadline only applies to the round trip of this request, not to the time it waits in
+// the request queue; other requests keep the client timeout. Running into the deadline is expected
+// and the change is saved as usual, any other failure is returned.
 func (c *HRUIClient) SetSTPSettingsAsync(ctx context.Context, stp *STPGlobalSettings) error {
-	stpURL := c.URL + "/loop.cgi?page=stp_global"
+	stpURL := c.BaseURL() + "/loop.cgi?page=stp_global"
 
 	// Prepare form data for POST request
 	data := url.Values{
@@ -185,11 +193,24 @@ func (c *HRUIClient) SetSTPSettingsAsync(ctx context.Context, stp *STPGlobalSett
 		"cmd":      []string{"stp"},
 	}
 
-	c.HttpClient.Timeout = 2 * time.Second
-	_, err := c.FormRequest(ctx, stpURL, data)
-	if err != nil {
-		log.Printf("[WARN] POST request timed out or failed: %v", err)
-		return nil
+	headers := map[string]string{
+		"Content-Type": "application/x-www-form-urlencoded",
+	}
+
+	respBody, err := c.send(withRequestTimeout(ctx, stpApplyTimeout), "POST", stpURL, strings.NewReader(data.Encode()), headers, false)
+	switch {
+	case errors.Is(err, context.DeadlineExceeded) && ctx.Err() == nil:
+		tflog.Debug(ctx, "STP global settings request did not answer, assuming it was applied")
+	case err != nil:
+		return fmt.Errorf("failed to update STP global settings: %w", err)
+	default:
+		if err := checkDeviceAlert(stpURL, respBody); err != nil {
+			return err
+		}
+	}
+
+	if err := c.saveChanges(ctx); err != nil {
+		return fmt.Errorf("STP global settings updated, but saving configuration failed: %w", err)
 	}
 
 	return nil
@@ -197,7 +218,7 @@ func (c *HRUIClient) SetSTPSettingsAsync(ctx context.Context, stp *STPGlobalSett
 
 // GetSTPPortSettings fetches the STP port settings.
 func (c *HRUIClient) GetSTPPortSettings(ctx context.Context) ([]STPPort, error) {
-	stpURL := c.URL + "/loop.cgi?page=stp_port"
+	stpURL := c.BaseURL() + "/loop.cgi?page=stp_port"
 
 	respBody, err := c.Request(ctx, "GET", stpURL, nil, nil)
 	if err != nil {
@@ -256,7 +277,7 @@ func (c *HRUIClient) GetSTPPortSettings(ctx context.Context) ([]STPPort, error)
 	})
 
 	if len(stpPorts) == 0 {
-		return nil, fmt.Errorf("no STP ports found in settings table")
+		return nil, &ParseError{Page: "/loop.cgi?page=stp_port", Selector: "table tr"}
 	}
 
 	return stpPorts, nil
@@ -270,7 +291,7 @@ func (c *HRUIClient) SetSTPPortSettings(ctx context.Context, portName string, pa
 	}
 
 	// Construct the STP settings URL
-	stpURL := c.URL + "/loop.cgi?page=stp_port"
+	stpURL := c.BaseURL() + "/loop.cgi?page=stp_port"
 
 	// Prepare the form data
 	formData := url.Values{
@@ -284,7 +305,7 @@ func (c *HRUIClient) SetSTPPortSettings(ctx context.Context, portName string, pa
 	}
 
 	// Send the request to update STP settings
-	_, err = c.FormRequest(ctx, stpURL, formData)
+	_, err = c.idempotentFormRequest(ctx, stpURL, formData)
 	if err != nil {
 		return fmt.Errorf("failed to update STP port settings for port '%s': %w", portName, err)
 	}
@@ -305,7 +326,7 @@ func (c *HRUIClient) GetSTPPort(ctx context.Context, portName string) (*STPPort,
 		}
 	}
 
-	return nil, fmt.Errorf("port with name '%s' not found", portName)
+	return nil, fmt.Errorf("port with name '%s' %w", portName, ErrNotFound)
 }
 
 func parsePortStatuses(doc *goquery.Document) []PortStatus {
@@ -343,7 +364,7 @@ func parsePortStatuses(doc *goquery.Document) []PortStatus {
 func extractText(doc *goquery.Document, selector string) (string, error) {
 	selection := strings.TrimSpace(doc.Find(selector).Text())
 	if selection == "" {
-		return "", fmt.Errorf("missing value for selector: %s", selector)
+		return "", &ParseError{Selector: selector}
 	}
 	return selection, nil
 }
@@ -38,7 +38,7 @@ func formatPort(rawPort string) string {
 
 // GetMACAddressTable fetches and parses the MAC table from the switch.
 func (c *HRUIClient) GetMACAddressTable(ctx context.Context) ([]MACAddressEntry, error) {
-	url := c.URL + "/mac.cgi?page=fwd_tbl"
+	url := c.BaseURL() + "/mac.cgi?page=fwd_tbl"
 
 	respBody, err := c.Request(ctx, "GET", url, nil, nil)
 	if err != nil {
@@ -51,6 +51,11 @@ func (c *HRUIClient) GetMACAddressTable(ctx context.Context) ([]MACAddressEntry,
 		return nil, fmt.Errorf("failed to parse MAC table HTML: %w", err)
 	}
 
+	return c.parsers().macTable.parse(doc)
+}
+
+// parseMACTable extracts the entries of the MAC address table page.
+func parseMACTable(doc *goquery.Document) ([]MACAddressEntry, error) {
 	// Extract MAC address entries from the table
 	var entries []MACAddressEntry
 	doc.Find("table tr").Each(func(i int, row *goquery.Selection) {
@@ -85,9 +90,85 @@ func (c *HRUIClient) GetMACAddressTable(ctx context.Context) ([]MACAddressEntry,
 	return entries, nil
 }
 
+// Range and factory default of the MAC address aging time, in seconds.
+const (
+	MinMACAgingTime     = 10
+	MaxMACAgingTime     = 1000000
+	DefaultMACAgingTime = 300
+)
+
+// macAgingPage is the MAC address table page, which also holds the aging time setting.
+const macAgingPage = "mac.cgi?page=fwd_tbl"
+
+// macAgingFeature names the aging time setting in ErrUnsupportedFeature errors.
+const macAgingFeature = "configuring the MAC aging time"
+
+// GetMACAgingTime retrieves the time in seconds after which dynamic entries are removed from
+// the MAC address table. It returns ErrUnsupportedFeature if the firmware has no aging setting,
+// see Capabilities.MACAging.
+func (c *HRUIClient) GetMACAgingTime(ctx context.Context) (int, error) {
+	if err := c.requireCapability(c.Capabilities().MACAging, macAgingFeature); err != nil {
+		return 0, err
+	}
+
+	respBody, err := c.Request(ctx, "GET", fmt.Sprintf("%s/%s", c.BaseURL(), macAgingPage), nil, nil)
+	if err != nil {
+		return 0, fmt.Errorf("error fetching MAC aging time: %w", err)
+	}
+
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(string(respBody)))
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse MAC table HTML: %w", err)
+	}
+
+	agingTime, err := c.parsers().macAging.parse(doc)
+	if err != nil {
+		return 0, c.optionalPageError(err, c.Capabilities().MACAging, macAgingFeature)
+	}
+	return agingTime, nil
+}
+
+// SetMACAgingTime sets the MAC address aging time in seconds, between MinMACAgingTime and
+// MaxMACAgingTime. It returns ErrUnsupportedFeature if the firmware has no aging setting.
+func (c *HRUIClient) SetMACAgingTime(ctx context.Context, seconds int) error {
+	if seconds < MinMACAgingTime || seconds > MaxMACAgingTime {
+		return fmt.Errorf("invalid MAC aging time '%d': must be between %d and %d seconds", seconds, MinMACAgingTime, MaxMACAgingTime)
+	}
+
+	if err := c.requireCapability(c.Capabilities().MACAging, macAgingFeature); err != nil {
+		return err
+	}
+
+	formData := url.Values{}
+	formData.Set("aging_time", strconv.Itoa(seconds))
+
+	_, err := c.idempotentFormRequest(ctx, fmt.Sprintf("%s/%s", c.BaseURL(), macAgingPage), formData)
+	if err != nil {
+		return fmt.Errorf("failed to set MAC aging time: %w", err)
+	}
+
+	return nil
+}
+
+// parseMACAgingTime reads the aging time input of the MAC address table page.
+func parseMACAgingTime(doc *goquery.Document) (int, error) {
+	selector := "input[name='aging_time']"
+	selection := doc.Find(selector)
+	if selection.Length() == 0 {
+		return 0, &ParseError{Page: "/" + macAgingPage, Selector: selector}
+	}
+
+	value, _ := selection.Attr("value")
+	seconds, err := strconv.Atoi(strings.TrimSpace(value))
+	if err != nil {
+		return 0, fmt.Errorf("invalid MAC aging time %q: %w", value, err)
+	}
+	return seconds, nil
+}
+
 // GetStaticMACAddressTable retrieves the static MAC address table.
 func (c *HRUIClient) GetStaticMACAddressTable(ctx context.Context) ([]StaticMACEntry, error) {
-	url := c.URL + "/mac.cgi?page=static"
+	url := c.BaseURL() + "/mac.cgi?page=static"
 
 	respBody, err := c.Request(ctx, "GET", url, nil, nil)
 	if err != nil {
@@ -131,6 +212,22 @@ func (c *HRUIClient) GetStaticMACAddressTable(ctx context.Context) ([]StaticMACE
 	return entries, nil
 }
 
+// GetStaticMACEntry fetches the static MAC entry for a MAC address in a VLAN.
+func (c *HRUIClient) GetStaticMACEntry(ctx context.Context, mac string, vlanID int) (*StaticMACEntry, error) {
+	entries, err := c.GetStaticMACAddressTable(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, entry := range entries {
+		if entry.MACAddress == mac && entry.VLANID == vlanID {
+			return &entry, nil
+		}
+	}
+
+	return nil, fmt.Errorf("static MAC entry %s in VLAN %d %w", mac, vlanID, ErrNotFound)
+}
+
 // AddStaticMACEntry adds a new static MAC address entry by sending a POST request.
 func (c *HRUIClient) AddStaticMACEntry(ctx context.Context, mac string, vlanID int, portName string) error {
 	portID, err := c.GetPortByName(ctx, portName)
@@ -145,7 +242,7 @@ func (c *HRUIClient) AddStaticMACEntry(ctx context.Context, mac string, vlanID i
 		"cmd":  []string{"macstatic"},
 	}
 
-	_, err = c.FormRequest(ctx, c.URL+"/mac.cgi?page=static", formData)
+	_, err = c.FormRequest(ctx, c.BaseURL()+"/mac.cgi?page=static", formData)
 	if err != nil {
 		return fmt.Errorf("error adding static MAC address: %w", err)
 	}
@@ -165,7 +262,7 @@ func (c *HRUIClient) RemoveStaticMACEntries(ctx context.Context, macEntries []St
 		formData.Add("del", checkboxValue)
 	}
 
-	_, err := c.FormRequest(ctx, c.URL+"/mac.cgi?page=staticdel", formData)
+	_, err := c.FormRequest(ctx, c.BaseURL()+"/mac.cgi?page=staticdel", formData)
 	if err != nil {
 		return fmt.Errorf("error deleting static MAC addresses: %w", err)
 	}
@@ -20,7 +20,7 @@ type MACLimit struct {
 // GetMACLimits fetches the current MAC limits configuration for all ports.
 func (c *HRUIClient) GetMACLimits(ctx context.Context) ([]MACLimit, error) {
 	// Execute a GET request to retrieve the MAC constraints HTML page.
-	respBody, err := c.Request(ctx, "GET", fmt.Sprintf("%s/mac_constraint.cgi", c.URL), nil, nil)
+	respBody, err := c.Request(ctx, "GET", fmt.Sprintf("%s/mac_constraint.cgi", c.BaseURL()), nil, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch MAC constraints page: %w", err)
 	}
@@ -83,14 +83,15 @@ func (c *HRUIClient) SetMACLimit(ctx context.Context, portID int, enabled bool,
 	}
 
 	// Send the POST request to apply changes.
-	respBody, err := c.FormRequest(ctx, fmt.Sprintf("%s/mac_constraint.cgi", c.URL), formData)
+	endpoint := fmt.Sprintf("%s/mac_constraint.cgi", c.BaseURL())
+	respBody, err := c.idempotentFormRequest(ctx, endpoint, formData)
 	if err != nil {
 		return fmt.Errorf("failed to update MAC constraints: %w", err)
 	}
 
-	// Optionally, handle any errors reported in the response.
+	// Some firmware reports rejected limits in the page instead of an alert dialog.
 	if strings.Contains(string(respBody), "Error") {
-		return fmt.Errorf("device reported an error when setting MAC constraints: %s", string(respBody))
+		return fmt.Errorf("failed to update MAC constraints: %w", &DeviceAlertError{Message: pageText(respBody), Page: pagePath(endpoint)})
 	}
 
 	return nil
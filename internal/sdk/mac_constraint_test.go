@@ -2,6 +2,7 @@ package sdk
 
 import (
 	"context"
+	"errors"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -161,6 +162,26 @@ func TestSetMACLimit(t *testing.T) {
 	})
 }
 
+// TestSetMACLimit_DeviceError tests that an error reported in the page is returned as a DeviceAlertError.
+func TestSetMACLimit_DeviceError(t *testing.T) {
+	server := mockServerMock(`<html><body><p>Error:  limit exceeds
+		the table size</p></body></html>`, http.StatusOK)
+	defer server.Close()
+
+	client := &HRUIClient{HttpClient: server.Client(), URL: server.URL}
+
+	limit := 9000
+	err := client.SetMACLimit(context.Background(), 1, true, &limit)
+
+	var alertErr *DeviceAlertError
+	if !errors.As(err, &alertErr) {
+		t.Fatalf("expected a DeviceAlertError, got %v", err)
+	}
+	if alertErr.Message != "Error: limit exceeds the table size" || alertErr.Page != "/mac_constraint.cgi" {
+		t.Errorf("unexpected alert: %+v", alertErr)
+	}
+}
+
 // Helper function to add custom headers in the test transport layer.
 func addTestIDHeader(headers http.Header, baseTransport http.RoundTripper) http.RoundTripper {
 	return roundTripperFunc(func(req *http.Request) (*http.Response, error) {
@@ -4,9 +4,11 @@ import (
 	"context"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestGetMACAddressTable(t *testing.T) {
@@ -130,6 +132,14 @@ func TestGetStaticMACAddressTable(t *testing.T) {
 		{ID: 2, MACAddress: "BB:44:55:66:77:88", VLANID: 2, Port: "Trunk2"},
 	}
 	assert.Equal(t, expected, entries)
+
+	// Look up single entries; the VLAN is part of the key
+	entry, err := client.GetStaticMACEntry(context.Background(), "BB:44:55:66:77:88", 2)
+	require.NoError(t, err)
+	assert.Equal(t, &expected[1], entry)
+
+	_, err = client.GetStaticMACEntry(context.Background(), "BB:44:55:66:77:88", 1)
+	assert.ErrorIs(t, err, ErrNotFound)
 }
 
 func TestAddStaticMACEntry(t *testing.T) {
@@ -205,3 +215,117 @@ func TestRemoveStaticMACEntries(t *testing.T) {
 	err := client.RemoveStaticMACEntries(context.Background(), entries)
 	assert.NoError(t, err)
 }
+
+const macAgingHTML = `<html>
+<body>
+<form method="post" action="/mac.cgi?page=fwd_tbl">
+	Aging Time: <input type="text" name="aging_time" value="300" maxlength="7"> sec
+</form>
+<table>
+	<tr><th>No.</th><th>MAC Address</th><th>VLAN ID</th><th>Type</th><th>Port</th></tr>
+	<tr><td>1</td><td>AA:BB:CC:DD:EE:FF</td><td>1</td><td>dynamic</td><td>1</td></tr>
+</table>
+</body>
+</html>`
+
+// withMACAging returns a client for firmware with the MAC aging time setting. No recorded firmware
+// has it, so the capability is off by default.
+func withMACAging(server *httptest.Server) *HRUIClient {
+	client := &HRUIClient{URL: server.URL, HttpClient: server.Client()}
+	client.setCapabilities(Capabilities{MACAging: true})
+	return client
+}
+
+func TestGetMACAgingTime(t *testing.T) {
+	server := mockServerMock(macAgingHTML, http.StatusOK)
+	defer server.Close()
+
+	client := withMACAging(server)
+	agingTime, err := client.GetMACAgingTime(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, 300, agingTime)
+
+	// The aging time form does not affect the MAC address table.
+	entries, err := client.GetMACAddressTable(context.Background())
+	require.NoError(t, err)
+	assert.Len(t, entries, 1)
+}
+
+func TestGetMACAgingTime_Unsupported(t *testing.T) {
+	requested := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requested = true
+	}))
+	defer server.Close()
+
+	for _, firmware := range []string{"V1.9", "V1.9.1", "V2.0"} {
+		client := &HRUIClient{URL: server.URL, HttpClient: server.Client()}
+		client.setCapabilities(capabilitiesFor(firmware, "V1.0"))
+		_, err := client.GetMACAgingTime(context.Background())
+
+		assert.ErrorIs(t, err, ErrUnsupportedFeature, firmware)
+	}
+	assert.False(t, requested, "no known firmware has the aging time setting")
+}
+
+func TestGetMACAgingTime_UnknownFirmwareWithoutSetting(t *testing.T) {
+	server := mockServerMock(`<html><body><table><tr><th>No.</th></tr></table></body></html>`, http.StatusOK)
+	defer server.Close()
+
+	client := withMACAging(server)
+	_, err := client.GetMACAgingTime(context.Background())
+
+	assert.ErrorIs(t, err, ErrUnsupportedFeature)
+}
+
+func TestGetMACAgingTime_UnexpectedLayout(t *testing.T) {
+	server := mockServerMock(`<html><body><table><tr><th>No.</th></tr></table></body></html>`, http.StatusOK)
+	defer server.Close()
+
+	// Firmware known to have the setting, so a page without it is a layout problem.
+	client := &HRUIClient{URL: server.URL, HttpClient: server.Client()}
+	client.setCapabilities(Capabilities{Known: true, FirmwareVersion: "V2.0", MACAging: true})
+	_, err := client.GetMACAgingTime(context.Background())
+
+	var parseErr *ParseError
+	require.ErrorAs(t, err, &parseErr)
+	assert.NotErrorIs(t, err, ErrUnsupportedFeature)
+}
+
+func TestSetMACAgingTime(t *testing.T) {
+	var receivedForm url.Values
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			require.NoError(t, r.ParseForm())
+			assert.Equal(t, "page=fwd_tbl", r.URL.RawQuery)
+			receivedForm = r.PostForm
+		}
+		_, _ = w.Write([]byte(macAgingHTML))
+	}))
+	defer server.Close()
+
+	client := withMACAging(server)
+	err := client.SetMACAgingTime(context.Background(), 60)
+
+	require.NoError(t, err)
+	assert.Equal(t, url.Values{"aging_time": {"60"}}, receivedForm)
+
+	assert.ErrorContains(t, client.SetMACAgingTime(context.Background(), 5), "must be between 10 and 1000000 seconds")
+	assert.Error(t, client.SetMACAgingTime(context.Background(), 1000001))
+}
+
+func TestSetMACAgingTime_Unsupported(t *testing.T) {
+	posted := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		posted = posted || r.Method == http.MethodPost
+	}))
+	defer server.Close()
+
+	client := &HRUIClient{URL: server.URL, HttpClient: server.Client()}
+	client.setCapabilities(capabilitiesFor("V1.9", "V1.0"))
+	err := client.SetMACAgingTime(context.Background(), 60)
+
+	assert.ErrorIs(t, err, ErrUnsupportedFeature)
+	assert.False(t, posted, "the setting must not be posted to firmware without it")
+}
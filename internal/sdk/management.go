@@ -0,0 +1,106 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+// ManagementSettings represents the settings of the management interface that are not part of
+// IPAddressSettings.
+type ManagementSettings struct {
+	// VLANID is the VLAN the management interface listens on.
+	VLANID int
+}
+
+// managementVLANPage is the page of the management VLAN setting.
+const managementVLANPage = "ip.cgi?page=mgmt_vlan"
+
+// managementVLANFeature names the management VLAN setting in ErrUnsupportedFeature errors.
+const managementVLANFeature = "configuring the management VLAN"
+
+// GetManagementSettings retrieves the management interface settings.
+// It returns ErrUnsupportedFeature if the firmware has no management VLAN setting, see
+// Capabilities.ManagementVLAN.
+func (c *HRUIClient) GetManagementSettings(ctx context.Context) (*ManagementSettings, error) {
+	if err := c.requireCapability(c.Capabilities().ManagementVLAN, managementVLANFeature); err != nil {
+		return nil, err
+	}
+
+	respBody, err := c.Request(ctx, "GET", fmt.Sprintf("%s/%s", c.BaseURL(), managementVLANPage), nil, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch management settings from HRUI: %w", err)
+	}
+
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(string(respBody)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse management settings HTML output: %w", err)
+	}
+
+	settings, err := c.parsers().management.parse(doc)
+	if err != nil {
+		return nil, c.optionalPageError(err, c.Capabilities().ManagementVLAN, managementVLANFeature)
+	}
+	return settings, nil
+}
+
+// SetManagementSettings updates the management interface settings. Moving the management VLAN
+// loses the connection unless the port the provider reaches the switch through is a member of the
+// new VLAN, see CheckManagementVLAN. It returns ErrUnsupportedFeature if the firmware has no
+// management VLAN setting.
+func (c *HRUIClient) SetManagementSettings(ctx context.Context, settings *ManagementSettings) error {
+	if settings.VLANID < 1 || settings.VLANID > 4094 {
+		return fmt.Errorf("invalid management VLAN ID: %d", settings.VLANID)
+	}
+
+	if err := c.requireCapability(c.Capabilities().ManagementVLAN, managementVLANFeature); err != nil {
+		return err
+	}
+
+	form := url.Values{}
+	form.Set("mgmt_vlan", strconv.Itoa(settings.VLANID))
+
+	_, err := c.idempotentFormRequest(ctx, fmt.Sprintf("%s/%s", c.BaseURL(), managementVLANPage), form)
+	if err != nil {
+		return fmt.Errorf("failed to update management settings: %w", err)
+	}
+
+	return nil
+}
+
+// CheckManagementVLAN returns an error if uplinkPort, the port the management station reaches the
+// switch through, is not a member of the VLAN vlanID, so that moving the management interface to
+// that VLAN would cut off access to the switch.
+func CheckManagementVLAN(vlans []*Vlan, vlanID int, uplinkPort string) error {
+	for _, vlan := range vlans {
+		if vlan.VlanID != vlanID {
+			continue
+		}
+		if slices.Contains(vlan.UntaggedPorts, uplinkPort) || slices.Contains(vlan.TaggedPorts, uplinkPort) {
+			return nil
+		}
+		return fmt.Errorf("uplink port '%s' is not a member of VLAN %d", uplinkPort, vlanID)
+	}
+	return fmt.Errorf("VLAN %d %w", vlanID, ErrNotFound)
+}
+
+// parseManagementSettings reads the management VLAN input.
+func parseManagementSettings(doc *goquery.Document) (*ManagementSettings, error) {
+	selector := "input[name='mgmt_vlan']"
+	selection := doc.Find(selector)
+	if selection.Length() == 0 {
+		return nil, &ParseError{Page: "/" + managementVLANPage, Selector: selector}
+	}
+
+	value, _ := selection.Attr("value")
+	vlanID, err := strconv.Atoi(strings.TrimSpace(value))
+	if err != nil {
+		return nil, fmt.Errorf("invalid management VLAN %q: %w", value, err)
+	}
+	return &ManagementSettings{VLANID: vlanID}, nil
+}
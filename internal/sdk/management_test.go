@@ -0,0 +1,127 @@
+package sdk
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const managementVLANHTML = `<form method="post" action="/ip.cgi?page=mgmt_vlan">
+	<table>
+		<tr><th>Management VLAN</th><td><input type="text" name="mgmt_vlan" value="10" maxlength="4"></td></tr>
+	</table>
+</form>`
+
+// withManagementVLAN returns a client for firmware with the management VLAN setting. No recorded
+// firmware has it, so the capability is off by default.
+func withManagementVLAN(server *httptest.Server) *HRUIClient {
+	client := &HRUIClient{URL: server.URL, HttpClient: server.Client()}
+	client.setCapabilities(Capabilities{ManagementVLAN: true})
+	return client
+}
+
+func TestGetManagementSettings(t *testing.T) {
+	server := mockServerMock(managementVLANHTML, http.StatusOK)
+	defer server.Close()
+
+	client := withManagementVLAN(server)
+	settings, err := client.GetManagementSettings(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, &ManagementSettings{VLANID: 10}, settings)
+}
+
+func TestGetManagementSettings_Unsupported(t *testing.T) {
+	requested := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requested = true
+	}))
+	defer server.Close()
+
+	for _, firmware := range []string{"V1.9", "V1.9.1", "V2.0"} {
+		client := &HRUIClient{URL: server.URL, HttpClient: server.Client()}
+		client.setCapabilities(capabilitiesFor(firmware, "V1.0"))
+		_, err := client.GetManagementSettings(context.Background())
+
+		assert.ErrorIs(t, err, ErrUnsupportedFeature, firmware)
+	}
+	assert.False(t, requested, "no known firmware has the management VLAN setting")
+}
+
+func TestGetManagementSettings_UnknownFirmwareWithoutSetting(t *testing.T) {
+	// Firmware without the setting serves the plain IP address page.
+	server := mockServerMock(`<form method="post" action="ip.cgi"><input name="ip" value="192.168.1.1"></form>`, http.StatusOK)
+	defer server.Close()
+
+	client := withManagementVLAN(server)
+	_, err := client.GetManagementSettings(context.Background())
+
+	assert.ErrorIs(t, err, ErrUnsupportedFeature)
+}
+
+func TestGetManagementSettings_UnexpectedLayout(t *testing.T) {
+	server := mockServerMock(`<form method="post" action="ip.cgi"><input name="ip" value="192.168.1.1"></form>`, http.StatusOK)
+	defer server.Close()
+
+	// Firmware known to have the setting, so a page without it is a layout problem.
+	client := &HRUIClient{URL: server.URL, HttpClient: server.Client()}
+	client.setCapabilities(Capabilities{Known: true, FirmwareVersion: "V2.0", ManagementVLAN: true})
+	_, err := client.GetManagementSettings(context.Background())
+
+	var parseErr *ParseError
+	require.ErrorAs(t, err, &parseErr)
+	assert.NotErrorIs(t, err, ErrUnsupportedFeature)
+}
+
+func TestSetManagementSettings(t *testing.T) {
+	var receivedForm url.Values
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			require.NoError(t, r.ParseForm())
+			assert.Equal(t, "page=mgmt_vlan", r.URL.RawQuery)
+			receivedForm = r.PostForm
+		}
+		_, _ = w.Write([]byte(managementVLANHTML))
+	}))
+	defer server.Close()
+
+	client := withManagementVLAN(server)
+	err := client.SetManagementSettings(context.Background(), &ManagementSettings{VLANID: 20})
+
+	require.NoError(t, err)
+	assert.Equal(t, "20", receivedForm.Get("mgmt_vlan"))
+
+	assert.Error(t, client.SetManagementSettings(context.Background(), &ManagementSettings{VLANID: 4095}))
+}
+
+func TestSetManagementSettings_Unsupported(t *testing.T) {
+	posted := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		posted = posted || r.Method == http.MethodPost
+	}))
+	defer server.Close()
+
+	client := &HRUIClient{URL: server.URL, HttpClient: server.Client()}
+	client.setCapabilities(Capabilities{Known: true, FirmwareVersion: "V1.0"})
+	err := client.SetManagementSettings(context.Background(), &ManagementSettings{VLANID: 20})
+
+	assert.ErrorIs(t, err, ErrUnsupportedFeature)
+	assert.False(t, posted, "the setting must not be posted to firmware without it")
+}
+
+func TestCheckManagementVLAN(t *testing.T) {
+	vlans := []*Vlan{
+		{VlanID: 1, UntaggedPorts: []string{"Port 1", "Port 2"}},
+		{VlanID: 10, UntaggedPorts: []string{"Port 3"}, TaggedPorts: []string{"Port 1"}},
+	}
+
+	assert.NoError(t, CheckManagementVLAN(vlans, 10, "Port 1"), "tagged members can reach the switch")
+	assert.NoError(t, CheckManagementVLAN(vlans, 10, "Port 3"))
+	assert.EqualError(t, CheckManagementVLAN(vlans, 10, "Port 2"), "uplink port 'Port 2' is not a member of VLAN 10")
+	assert.ErrorIs(t, CheckManagementVLAN(vlans, 20, "Port 1"), ErrNotFound)
+}
@@ -0,0 +1,108 @@
+package sdk
+
+import (
+	"github.com/PuerkitoBio/goquery"
+)
+
+// pageParser extracts T from a page of the device web UI.
+type pageParser[T any] interface {
+	parse(doc *goquery.Document) (T, error)
+}
+
+// parserFunc adapts a plain function to the pageParser interface.
+type parserFunc[T any] func(doc *goquery.Document) (T, error)
+
+func (f parserFunc[T]) parse(doc *goquery.Document) (T, error) {
+	return f(doc)
+}
+
+// parserSet holds one parser per page whose layout depends on the firmware.
+type parserSet struct {
+	ports            pageParser[[]*Port]
+	vlans            pageParser[[]*Vlan]
+	bandwidthControl pageParser[[]BandwidthControl]
+	stormControl     pageParser[[]StormControlEntry]
+	macTable         pageParser[[]MACAddressEntry]
+	igmpPorts        pageParser[map[int]string]
+	systemInfo       pageParser[*SystemInfo]
+	vlanMode         pageParser[VLANMode]
+	portBasedVLANs   pageParser[[]*PortBasedVLAN]
+	management       pageParser[*ManagementSettings]
+	ipv6             pageParser[*IPv6Settings]
+	macAging         pageParser[int]
+}
+
+// defaultParsers understands the page layout of the firmware versions the provider is tested against.
+var defaultParsers = parserSet{
+	ports:            parserFunc[[]*Port](parsePortTable),
+	vlans:            parserFunc[[]*Vlan](parseVLANTable),
+	bandwidthControl: parserFunc[[]BandwidthControl](parseBandwidthControlTable),
+	stormControl:     parserFunc[[]StormControlEntry](parseStormControlTable),
+	macTable:         parserFunc[[]MACAddressEntry](parseMACTable),
+	igmpPorts:        parserFunc[map[int]string](parseAllPortsIGMPStatus),
+	systemInfo:       parserFunc[*SystemInfo](parseSystemInfo),
+	vlanMode:         parserFunc[VLANMode](parseVLANMode),
+	portBasedVLANs:   parserFunc[[]*PortBasedVLAN](parsePortBasedVLANTable),
+	management:       parserFunc[*ManagementSettings](parseManagementSettings),
+	ipv6:             parserFunc[*IPv6Settings](parseIPv6Settings),
+	macAging:         parserFunc[int](parseMACAgingTime),
+}
+
+// parserRegistry maps firmware versions, keyed like firmwareCapabilities, to their parsers.
+// Supporting a firmware with a different page layout only requires registering a set that
+// overrides the affected pages; unset pages fall back to defaultParsers.
+var parserRegistry = map[string]parserSet{
+	"1.9":   defaultParsers,
+	"1.9.1": defaultParsers,
+}
+
+// parsers returns the parser set for the detected firmware, or defaultParsers if the
+// firmware is unknown or detection has not run.
+func (c *HRUIClient) parsers() parserSet {
+	set, ok := parserRegistry[normalizeFirmwareVersion(c.Capabilities().FirmwareVersion)]
+	if !ok {
+		return defaultParsers
+	}
+	return set.withDefaults()
+}
+
+// withDefaults fills the pages a parser set does not override with defaultParsers.
+func (s parserSet) withDefaults() parserSet {
+	if s.ports == nil {
+		s.ports = defaultParsers.ports
+	}
+	if s.vlans == nil {
+		s.vlans = defaultParsers.vlans
+	}
+	if s.bandwidthControl == nil {
+		s.bandwidthControl = defaultParsers.bandwidthControl
+	}
+	if s.stormControl == nil {
+		s.stormControl = defaultParsers.stormControl
+	}
+	if s.macTable == nil {
+		s.macTable = defaultParsers.macTable
+	}
+	if s.igmpPorts == nil {
+		s.igmpPorts = defaultParsers.igmpPorts
+	}
+	if s.systemInfo == nil {
+		s.systemInfo = defaultParsers.systemInfo
+	}
+	if s.vlanMode == nil {
+		s.vlanMode = defaultParsers.vlanMode
+	}
+	if s.portBasedVLANs == nil {
+		s.portBasedVLANs = defaultParsers.portBasedVLANs
+	}
+	if s.management == nil {
+		s.management = defaultParsers.management
+	}
+	if s.ipv6 == nil {
+		s.ipv6 = defaultParsers.ipv6
+	}
+	if s.macAging == nil {
+		s.macAging = defaultParsers.macAging
+	}
+	return s
+}
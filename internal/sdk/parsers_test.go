@@ -0,0 +1,48 @@
+package sdk
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsers_SelectedByFirmwareVersion(t *testing.T) {
+	parserRegistry["9.9"] = parserSet{
+		ports: parserFunc[[]*Port](func(doc *goquery.Document) ([]*Port, error) {
+			var ports []*Port
+			doc.Find("li").Each(func(_ int, li *goquery.Selection) {
+				ports = append(ports, &Port{ID: li.Text()})
+			})
+			return ports, nil
+		}),
+	}
+	defer delete(parserRegistry, "9.9")
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`<ul><li>Port 1</li><li>Port 2</li></ul>`))
+	}))
+	defer server.Close()
+
+	caps := capabilitiesFor("V9.9", "V1.0")
+	client := &HRUIClient{URL: server.URL, HttpClient: server.Client()}
+	client.setCapabilities(caps)
+
+	ports, err := client.ListPorts(context.Background())
+	require.NoError(t, err)
+	require.Len(t, ports, 2)
+	require.Equal(t, "Port 2", ports[1].ID)
+
+	// Pages the firmware does not override use the default parsers.
+	require.NotNil(t, client.parsers().vlans)
+
+	// Other firmware versions keep parsing the default page layout.
+	other := capabilitiesFor("V2.0", "V1.0")
+	client.setCapabilities(other)
+	ports, err = client.ListPorts(context.Background())
+	require.NoError(t, err)
+	require.Empty(t, ports)
+}
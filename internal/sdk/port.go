@@ -77,12 +77,12 @@ func (c *HRUIClient) GetPort(ctx context.Context, portID string) (*Port, error)
 			return port, nil
 		}
 	}
-	return nil, fmt.Errorf("port with ID %s not found", portID)
+	return nil, fmt.Errorf("port with ID %s %w", portID, ErrNotFound)
 }
 
 // GetPortByName fetches port.cgi, parses it, and resolves the numeric port ID for a given port name.
 func (c *HRUIClient) GetPortByName(ctx context.Context, portName string) (int, error) {
-	respBody, err := c.Request(ctx, "GET", fmt.Sprintf("%s/port.cgi", c.URL), nil, nil)
+	respBody, err := c.Request(ctx, "GET", fmt.Sprintf("%s/port.cgi", c.BaseURL()), nil, nil)
 	if err != nil {
 		return 0, fmt.Errorf("failed to fetch port.cgi: %w", err)
 	}
@@ -111,7 +111,7 @@ func (c *HRUIClient) GetPortByName(ctx context.Context, portName string) (int, e
 
 	// If portIDStr is still empty, the portName was not found
 	if portIDStr == "" {
-		return 0, fmt.Errorf("port name '%s' not found in port.cgi", portName)
+		return 0, fmt.Errorf("port name '%s' %w in port.cgi", portName, ErrNotFound)
 	}
 
 	// Convert the portIDStr to an int
@@ -125,7 +125,7 @@ func (c *HRUIClient) GetPortByName(ctx context.Context, portName string) (int, e
 
 // ListPorts retrieves information about all switch ports.
 func (c *HRUIClient) ListPorts(ctx context.Context) ([]*Port, error) {
-	portURL := fmt.Sprintf("%s/port.cgi", c.URL)
+	portURL := fmt.Sprintf("%s/port.cgi", c.BaseURL())
 
 	respBody, err := c.Request(ctx, "GET", portURL, nil, nil)
 	if err != nil {
@@ -138,6 +138,11 @@ func (c *HRUIClient) ListPorts(ctx context.Context) ([]*Port, error) {
 		return nil, fmt.Errorf("failed to parse HTML output: %w", err)
 	}
 
+	return c.parsers().ports.parse(doc)
+}
+
+// parsePortTable extracts the port settings from the third table of port.cgi.
+func parsePortTable(doc *goquery.Document) ([]*Port, error) {
 	var ports []*Port
 	doc.Find("body center fieldset table").Eq(2).Find("tr").Each(func(i int, tr *goquery.Selection) {
 		if i < 2 { // Skip header rows
@@ -194,6 +199,12 @@ func (c *HRUIClient) ConfigurePort(ctx context.Context, port *Port) (*Port, erro
 		return nil, fmt.Errorf("invalid SpeedDuplex value: %s", port.SpeedDuplexConfig)
 	}
 
+	if port.SpeedDuplexConfig == speedDuplexMapping[8] {
+		if err := c.requireCapability(c.Capabilities().TenGigabitPorts, "10G port speed"); err != nil {
+			return nil, err
+		}
+	}
+
 	var flowControlNumeric string
 	for k, v := range flowControlMapping {
 		if v == port.FlowControlConfig {
@@ -217,8 +228,8 @@ func (c *HRUIClient) ConfigurePort(ctx context.Context, port *Port) (*Port, erro
 	form.Set("speed_duplex", speedDuplexNumeric)
 	form.Set("flow", flowControlNumeric)
 
-	portsURL := fmt.Sprintf("%s/port.cgi", c.URL)
-	_, err = c.FormRequest(ctx, portsURL, form)
+	portsURL := fmt.Sprintf("%s/port.cgi", c.BaseURL())
+	_, err = c.idempotentFormRequest(ctx, portsURL, form)
 	if err != nil {
 		return nil, fmt.Errorf("failed to update port settings: %w", err)
 	}
@@ -266,7 +277,7 @@ func (c *HRUIClient) GetTotalPorts(ctx context.Context) (int, error) {
 	}
 
 	// Request the trunk group page
-	url := fmt.Sprintf("%s/trunk.cgi?page=group", c.URL)
+	url := fmt.Sprintf("%s/trunk.cgi?page=group", c.BaseURL())
 	body, err := c.Request(ctx, "GET", url, nil, nil)
 	if err != nil {
 		return 0, fmt.Errorf("failed to fetch trunk group page: %w", err)
@@ -285,7 +296,7 @@ func (c *HRUIClient) GetTotalPorts(ctx context.Context) (int, error) {
 	})
 
 	if totalPorts == 0 {
-		return 0, fmt.Errorf("no ports found in trunk group page")
+		return 0, &ParseError{Page: "/trunk.cgi?page=group", Selector: "select#portsel option"}
 	}
 
 	return totalPorts, nil
@@ -293,7 +304,7 @@ func (c *HRUIClient) GetTotalPorts(ctx context.Context) (int, error) {
 
 // GetPortStatistics retrieves port statistics from the switch.
 func (c *HRUIClient) GetPortStatistics(ctx context.Context) ([]*PortStatistics, error) {
-	statsURL := fmt.Sprintf("%s/port.cgi?page=stats", c.URL)
+	statsURL := fmt.Sprintf("%s/port.cgi?page=stats", c.BaseURL())
 
 	respBody, err := c.Request(ctx, "GET", statsURL, nil, nil)
 	if err != nil {
@@ -337,7 +348,7 @@ func (c *HRUIClient) GetPortStatistics(ctx context.Context) ([]*PortStatistics,
 // GetPortMirror fetches the current port mirroring configuration (if any).
 func (c *HRUIClient) GetPortMirror(ctx context.Context) (*PortMirror, error) {
 	// Fetch the mirroring configuration page
-	urlMirror := fmt.Sprintf("%s/port.cgi?page=mirroring", c.URL)
+	urlMirror := fmt.Sprintf("%s/port.cgi?page=mirroring", c.BaseURL())
 	respBody, err := c.Request(ctx, "GET", urlMirror, nil, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch Port Mirror settings: %w", err)
@@ -398,7 +409,7 @@ func formatPortValue(raw string) string {
 // ConfigurePortMirror sets up or updates port mirroring with the given configuration.
 func (c *HRUIClient) ConfigurePortMirror(ctx context.Context, p *PortMirror) error {
 	// Construct the URL for configuring port mirroring
-	urlMirror := fmt.Sprintf("%s/port.cgi?page=mirroring", c.URL)
+	urlMirror := fmt.Sprintf("%s/port.cgi?page=mirroring", c.BaseURL())
 	form := url.Values{}
 	form.Set("cmd", "mirror")
 
@@ -430,7 +441,7 @@ func (c *HRUIClient) ConfigurePortMirror(ctx context.Context, p *PortMirror) err
 	form.Set("mirrored_port", p.MirroredPort)
 
 	// Make the request to update port mirroring configuration
-	_, err = c.FormRequest(ctx, urlMirror, form)
+	_, err = c.idempotentFormRequest(ctx, urlMirror, form)
 	if err != nil {
 		return fmt.Errorf("failed to update port mirror settings: %w", err)
 	}
@@ -441,7 +452,7 @@ func (c *HRUIClient) ConfigurePortMirror(ctx context.Context, p *PortMirror) err
 // DeletePortMirror removes the current port mirroring configuration.
 func (c *HRUIClient) DeletePortMirror(ctx context.Context) error {
 	// Construct the URL for deleting port mirroring configuration
-	urlMirror := fmt.Sprintf("%s/port.cgi?page=delete_mirror", c.URL)
+	urlMirror := fmt.Sprintf("%s/port.cgi?page=delete_mirror", c.BaseURL())
 	form := url.Values{}
 	form.Set("cmd", "del_mirror")
 
@@ -457,7 +468,7 @@ func (c *HRUIClient) DeletePortMirror(ctx context.Context) error {
 // GetPortIsolation fetches the current port isolation configuration.
 func (c *HRUIClient) GetPortIsolation(ctx context.Context) ([]PortIsolation, error) {
 	// Fetch the port isolation page from the device
-	url := fmt.Sprintf("%s/port.cgi?page=isolation", c.URL)
+	url := fmt.Sprintf("%s/port.cgi?page=isolation", c.BaseURL())
 	respBody, err := c.Request(ctx, "GET", url, nil, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch port isolation page: %w", err)
@@ -498,6 +509,22 @@ func (c *HRUIClient) GetPortIsolation(ctx context.Context) ([]PortIsolation, err
 	return isolations, nil
 }
 
+// GetPortIsolationByPort fetches the isolation configuration of a single port by its name.
+func (c *HRUIClient) GetPortIsolationByPort(ctx context.Context, port string) (*PortIsolation, error) {
+	isolations, err := c.GetPortIsolation(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, isolation := range isolations {
+		if isolation.Port == port {
+			return &isolation, nil
+		}
+	}
+
+	return nil, fmt.Errorf("port isolation for port '%s' %w", port, ErrNotFound)
+}
+
 // parseIsolationList takes a raw isolation list string and parses it into normalized values.
 func parseIsolationList(raw string) []string {
 	if raw == "" {
@@ -572,7 +599,7 @@ func isNumeric(s string) bool {
 }
 
 func (c *HRUIClient) ConfigurePortIsolation(ctx context.Context, port string, isolationList []string) error {
-	endpoint := fmt.Sprintf("%s/port.cgi?page=isolation", c.URL)
+	endpoint := fmt.Sprintf("%s/port.cgi?page=isolation", c.BaseURL())
 
 	formData := url.Values{}
 	formData.Set("cmd", "portisolation")
@@ -581,7 +608,7 @@ func (c *HRUIClient) ConfigurePortIsolation(ctx context.Context, port string, is
 		formData.Add("isolationlist", isolation)
 	}
 
-	_, err := c.FormRequest(ctx, endpoint, formData)
+	_, err := c.idempotentFormRequest(ctx, endpoint, formData)
 	if err != nil {
 		return fmt.Errorf("failed to configure port isolation: %w", err)
 	}
@@ -591,6 +618,13 @@ func (c *HRUIClient) ConfigurePortIsolation(ctx context.Context, port string, is
 
 // DeletePortIsolation resets the isolation list for a specific port.
 func (c *HRUIClient) DeletePortIsolation(ctx context.Context, port string) error {
+	return c.exclusive(ctx, func(ctx context.Context) error {
+		return c.deletePortIsolation(ctx, port)
+	})
+}
+
+// deletePortIsolation implements DeletePortIsolation while holding the operation lock.
+func (c *HRUIClient) deletePortIsolation(ctx context.Context, port string) error {
 	ports, err := c.ListPorts(ctx)
 	if err != nil {
 		return fmt.Errorf("failed to retrieve ports to delete port isolation: %w", err)
@@ -601,7 +635,7 @@ func (c *HRUIClient) DeletePortIsolation(ctx context.Context, port string) error
 		allPorts = append(allPorts, p.ID)
 	}
 
-	endpoint := fmt.Sprintf("%s/port.cgi?page=isolation", c.URL)
+	endpoint := fmt.Sprintf("%s/port.cgi?page=isolation", c.BaseURL())
 
 	formData := url.Values{}
 	formData.Set("cmd", "portisolation")
@@ -610,7 +644,7 @@ func (c *HRUIClient) DeletePortIsolation(ctx context.Context, port string) error
 		formData.Add("isolationlist", p)
 	}
 
-	_, err = c.FormRequest(ctx, endpoint, formData)
+	_, err = c.idempotentFormRequest(ctx, endpoint, formData)
 	if err != nil {
 		return fmt.Errorf("failed to delete port isolation for port '%s': %w", port, err)
 	}
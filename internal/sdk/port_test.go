@@ -433,4 +433,12 @@ func TestGetPortIsolation(t *testing.T) {
 
 	// Assert that the parsed result matches the expected configuration
 	assert.Equal(t, expectedIsolationConfig, isolations)
+
+	// Look up single ports
+	isolation, err := client.GetPortIsolationByPort(context.Background(), "Trunk1")
+	require.NoError(t, err)
+	assert.Equal(t, &expectedIsolationConfig[1], isolation)
+
+	_, err = client.GetPortIsolationByPort(context.Background(), "Port 8")
+	assert.ErrorIs(t, err, ErrNotFound)
 }
@@ -26,7 +26,7 @@ type QoSQueueWeight struct {
 // and resolves their Port IDs using GetPortByName.
 func (c *HRUIClient) ListQoSPortQueues(ctx context.Context) ([]QoSPortQueue, error) {
 	// Perform the HTTP request to fetch QoS table.
-	respBody, err := c.Request(ctx, "GET", c.URL+"/qos.cgi?page=port_pri", nil, nil)
+	respBody, err := c.Request(ctx, "GET", c.BaseURL()+"/qos.cgi?page=port_pri", nil, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to request QoS Port Queues: %w", err)
 	}
@@ -91,7 +91,7 @@ func (c *HRUIClient) GetQoSPortQueue(ctx context.Context, portID int) (*QoSPortQ
 		}
 	}
 
-	return nil, fmt.Errorf("QoS Port Queue not found for Port ID %d", portID)
+	return nil, fmt.Errorf("QoS Port Queue %w for Port ID %d", ErrNotFound, portID)
 }
 
 // SetQoSPortQueue updates the QoS port queue for the given port.
@@ -103,10 +103,10 @@ func (c *HRUIClient) SetQoSPortQueue(ctx context.Context, portID, queue int) err
 	data.Set("port_priority", strconv.Itoa(queue-1)) // The new QoS queue value to set (0-based)
 
 	// Prepare the endpoint to send the update request to
-	updateURL := c.URL + "/qos.cgi?page=port_pri"
+	updateURL := c.BaseURL() + "/qos.cgi?page=port_pri"
 
 	// Send the POST request to update the QoS Port Queue
-	_, err := c.FormRequest(ctx, updateURL, data)
+	_, err := c.idempotentFormRequest(ctx, updateURL, data)
 	if err != nil {
 		return fmt.Errorf("failed to update QoS Port Queue: %w", err)
 	}
@@ -117,7 +117,7 @@ func (c *HRUIClient) SetQoSPortQueue(ctx context.Context, portID, queue int) err
 // ListQoSQueueWeights fetches the current queues and weights from the HTML page.
 func (c *HRUIClient) ListQoSQueueWeights(ctx context.Context) ([]QoSQueueWeight, error) {
 	// Use Request to fetch the HTML page with QoS queue weights
-	respBody, err := c.Request(ctx, "GET", c.URL+"/qos.cgi?page=pkt_sch", nil, nil)
+	respBody, err := c.Request(ctx, "GET", c.BaseURL()+"/qos.cgi?page=pkt_sch", nil, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to request queue weights page: %w", err)
 	}
@@ -161,10 +161,10 @@ func (c *HRUIClient) SetQoSQueueWeight(ctx context.Context, queue, weight int) e
 	data.Set("queueid", strconv.Itoa(queue-1)) // Queue (0-based for the backend)
 	data.Set("weight", strconv.Itoa(weight))   // Weight (already 0-based as expected)
 
-	updateURL := c.URL + "/qos.cgi?page=que_weight"
+	updateURL := c.BaseURL() + "/qos.cgi?page=que_weight"
 
 	// Send the POST request to update the queue weight using FormRequest
-	_, err := c.FormRequest(ctx, updateURL, data)
+	_, err := c.idempotentFormRequest(ctx, updateURL, data)
 	if err != nil {
 		return fmt.Errorf("failed to update QoS Queue Weight: %w", err)
 	}
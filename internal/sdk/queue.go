@@ -0,0 +1,96 @@
+package sdk
+
+import (
+	"context"
+	"time"
+)
+
+// acquire blocks until a request slot is available or the context is done.
+// The returned function must be called to release the slot once the response has been read.
+func (c *HRUIClient) acquire(ctx context.Context) (func(), error) {
+	c.slotsOnce.Do(func() {
+		limit := c.MaxConcurrentRequests
+		if limit < 1 {
+			limit = 1
+		}
+		c.slots = make(chan struct{}, limit)
+	})
+
+	select {
+	case c.slots <- struct{}{}:
+		return func() { <-c.slots }, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// requestTimeoutKey carries a timeout that replaces RequestTimeout for the requests made with a context.
+type requestTimeoutKey struct{}
+
+// withRequestTimeout returns a context whose requests time out d after they are sent, instead of
+// after RequestTimeout. Like RequestTimeout, it does not count the time spent waiting for a slot.
+func withRequestTimeout(ctx context.Context, d time.Duration) context.Context {
+	return context.WithValue(ctx, requestTimeoutKey{}, d)
+}
+
+// requestTimeout returns the timeout of a single request made with ctx.
+func (c *HRUIClient) requestTimeout(ctx context.Context) time.Duration {
+	if d, ok := ctx.Value(requestTimeoutKey{}).(time.Duration); ok {
+		return d
+	}
+	return c.RequestTimeout
+}
+
+// sessionKey marks a context whose request or operation already holds the session lock of a client.
+type sessionKey struct {
+	client *HRUIClient
+}
+
+// shareSession holds the session lock for reading until the returned function is called, unless
+// ctx already holds it. Every request holds it, so no request is in flight, or logs in again with
+// outdated credentials, while ownSession changes the credentials of the device.
+func (c *HRUIClient) shareSession(ctx context.Context) (context.Context, func()) {
+	if ctx.Value(sessionKey{c}) != nil {
+		return ctx, func() {}
+	}
+	c.session.RLock()
+	return context.WithValue(ctx, sessionKey{c}, true), c.session.RUnlock
+}
+
+// ownSession runs fn holding the session lock for writing: it waits for the requests in flight
+// and holds back new ones until fn returns. Requests made by fn with the context it is given run
+// without locking again. Unlike exclusive, this also stops requests that are not part of an operation.
+func (c *HRUIClient) ownSession(ctx context.Context, fn func(ctx context.Context) error) error {
+	c.session.Lock()
+	defer c.session.Unlock()
+
+	return fn(context.WithValue(ctx, sessionKey{c}, true))
+}
+
+// operationKey marks a context whose operation already holds the operation lock of a client.
+type operationKey struct {
+	client *HRUIClient
+}
+
+// exclusive runs fn while holding the operation lock of the client. Helpers that read the device
+// state and post a form derived from it use it, so no other such helper can change the state in
+// between and have its change overwritten. The request queue alone only orders single requests.
+// Helpers called by fn with the context it is given run without locking again.
+func (c *HRUIClient) exclusive(ctx context.Context, fn func(ctx context.Context) error) error {
+	if ctx.Value(operationKey{c}) != nil {
+		return fn(ctx)
+	}
+
+	c.operationOnce.Do(func() {
+		c.operation = make(chan struct{}, 1)
+	})
+
+	select {
+	case c.operation <- struct{}{}:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	defer func() { <-c.operation }()
+
+	return fn(context.WithValue(ctx, operationKey{c}, true))
+}
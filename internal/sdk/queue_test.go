@@ -0,0 +1,83 @@
+package sdk
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// concurrencyServer records the highest number of requests it handled at the same time.
+func concurrencyServer() (*httptest.Server, *int32) {
+	var inFlight, peak int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		current := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+
+		for {
+			old := atomic.LoadInt32(&peak)
+			if current <= old || atomic.CompareAndSwapInt32(&peak, old, current) {
+				break
+			}
+		}
+
+		time.Sleep(20 * time.Millisecond)
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	return server, &peak
+}
+
+func runConcurrentRequests(t *testing.T, client *HRUIClient, count int) {
+	var wg sync.WaitGroup
+	for range count {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := client.Request(context.Background(), "GET", client.URL+"/port.cgi", nil, nil)
+			require.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+}
+
+func TestRequestQueue_SerializesByDefault(t *testing.T) {
+	server, peak := concurrencyServer()
+	defer server.Close()
+
+	client := &HRUIClient{URL: server.URL, HttpClient: server.Client()}
+	runConcurrentRequests(t, client, 8)
+
+	require.Equal(t, int32(1), atomic.LoadInt32(peak))
+}
+
+func TestRequestQueue_HonoursMaxConcurrentRequests(t *testing.T) {
+	server, peak := concurrencyServer()
+	defer server.Close()
+
+	client := &HRUIClient{URL: server.URL, HttpClient: server.Client(), MaxConcurrentRequests: 3}
+	runConcurrentRequests(t, client, 12)
+
+	require.LessOrEqual(t, atomic.LoadInt32(peak), int32(3))
+	require.Greater(t, atomic.LoadInt32(peak), int32(1))
+}
+
+func TestRequestQueue_ContextCancelledWhileWaiting(t *testing.T) {
+	client := &HRUIClient{}
+
+	release, err := client.acquire(context.Background())
+	require.NoError(t, err)
+	defer release()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	_, err = client.acquire(ctx)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+}
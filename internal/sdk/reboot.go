@@ -0,0 +1,119 @@
+package sdk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// DefaultReadyTimeout is how long WaitForReady waits for the switch by default.
+	DefaultReadyTimeout = 5 * time.Minute
+
+	// defaultReadyPollInterval is how often WaitForReady tries to log in by default.
+	defaultReadyPollInterval = 5 * time.Second
+)
+
+// Reboot restarts the switch. Changes recorded by AutosaveBatch are saved first, as the
+// switch discards unsaved changes when it restarts. Use WaitForReady to wait for it to come back.
+func (c *HRUIClient) Reboot(ctx context.Context) error {
+	if err := c.CommitPendingChanges(ctx); err != nil {
+		return fmt.Errorf("failed to save pending changes before reboot: %w", err)
+	}
+
+	tflog.Info(ctx, "Rebooting switch")
+	return c.restart(ctx, "reboot.cgi", url.Values{"cmd": {"reboot"}}, "reboot")
+}
+
+// FactoryReset restores the factory defaults and restarts the switch. The switch comes back
+// with its default IP address and credentials, so the client cannot be used afterwards.
+func (c *HRUIClient) FactoryReset(ctx context.Context) error {
+	// The defaults replace anything that was waiting to be saved.
+	c.setPending(false)
+
+	tflog.Info(ctx, "Resetting switch to factory defaults")
+	return c.restart(ctx, "reset.cgi", url.Values{"cmd": {"factory_default"}}, "factory reset")
+}
+
+// restart posts a form that makes the switch restart.
+func (c *HRUIClient) restart(ctx context.Context, page string, formData url.Values, operation string) error {
+	if err := c.postDisconnecting(ctx, page, formData); err != nil {
+		return fmt.Errorf("failed to %s switch: %w", operation, err)
+	}
+	return nil
+}
+
+// postDisconnecting posts a form after which the switch may drop the connection instead of
+// answering, e.g. because it restarts or moves to another address. A dropped connection is taken
+// as a sign that the form was applied. The form is not retried: a second request could hit the
+// switch while it comes back. The configuration is not saved.
+func (c *HRUIClient) postDisconnecting(ctx context.Context, page string, formData url.Values) error {
+	endpoint := fmt.Sprintf("%s/%s", c.BaseURL(), page)
+	headers := map[string]string{
+		"Content-Type": "application/x-www-form-urlencoded",
+	}
+
+	respBody, err := c.send(ctx, "POST", endpoint, strings.NewReader(formData.Encode()), headers, false)
+	if err != nil {
+		var statusErr *httpStatusError
+		if errors.As(err, &statusErr) || !isRetryable(err) {
+			return err
+		}
+		tflog.Debug(ctx, "Switch closed the connection", map[string]any{"page": page, "error": err.Error()})
+		return nil
+	}
+
+	return checkDeviceAlert(endpoint, respBody)
+}
+
+// WaitForReady polls the switch until a login succeeds, e.g. after Reboot. The first attempt is
+// made after interval, so a switch that has not gone down yet is not taken as ready.
+// A zero timeout or interval selects DefaultReadyTimeout or 5 seconds.
+func (c *HRUIClient) WaitForReady(ctx context.Context, timeout, interval time.Duration) error {
+	return c.waitUntil(ctx, timeout, interval, c.Login)
+}
+
+// waitUntil calls check every interval until it succeeds or timeout elapses. Errors are expected
+// while the switch restarts, so only the last one is returned on timeout. The page cache is
+// flushed before every check, as pages cached before the restart may be out of date.
+func (c *HRUIClient) waitUntil(ctx context.Context, timeout, interval time.Duration, check func(ctx context.Context) error) error {
+	if timeout <= 0 {
+		timeout = DefaultReadyTimeout
+	}
+	if interval <= 0 {
+		interval = defaultReadyPollInterval
+	}
+
+	deadline := time.NewTimer(timeout)
+	defer deadline.Stop()
+
+	var lastErr error
+	for attempt := 1; ; attempt++ {
+		wait := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			wait.Stop()
+			return fmt.Errorf("aborted waiting for the switch: %w", ctx.Err())
+		case <-deadline.C:
+			wait.Stop()
+			if lastErr == nil {
+				return fmt.Errorf("switch not ready within %s", timeout)
+			}
+			return fmt.Errorf("switch not ready within %s: %w", timeout, lastErr)
+		case <-wait.C:
+		}
+
+		c.cache.flush(ctx)
+
+		if lastErr = check(ctx); lastErr == nil {
+			tflog.Debug(ctx, "Switch is ready", map[string]any{"attempt": attempt})
+			return nil
+		}
+		tflog.Debug(ctx, "Switch not ready yet", map[string]any{"attempt": attempt, "error": lastErr.Error()})
+	}
+}
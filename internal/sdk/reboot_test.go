@@ -0,0 +1,136 @@
+package sdk
+
+import (
+	"context"
+	"net/http"
+	"net/http/cookiejar"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// rebootServer emulates a restart: after a POST to reboot.cgi or reset.cgi the switch is
+// unavailable for downRequests requests. It records the paths of all POST requests.
+type rebootServer struct {
+	*httptest.Server
+
+	mu    sync.Mutex
+	down  int
+	posts []string
+	forms []string
+}
+
+func newRebootServer(t *testing.T, downRequests int, dropConnection bool) *rebootServer {
+	rs := &rebootServer{}
+	rs.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		rs.mu.Lock()
+		defer rs.mu.Unlock()
+
+		if rs.down > 0 {
+			rs.down--
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+
+		if r.Method != http.MethodPost {
+			return
+		}
+		require.NoError(t, r.ParseForm())
+		rs.posts = append(rs.posts, r.URL.Path)
+		rs.forms = append(rs.forms, r.PostForm.Get("cmd"))
+
+		if r.URL.Path == "/reboot.cgi" || r.URL.Path == "/reset.cgi" {
+			rs.down = downRequests
+			if dropConnection {
+				conn, _, err := w.(http.Hijacker).Hijack()
+				require.NoError(t, err)
+				_ = conn.Close()
+			}
+		}
+	}))
+	return rs
+}
+
+func newRebootClient(t *testing.T, url string, opts ...ClientOption) *HRUIClient {
+	opts = append([]ClientOption{WithFirmwareDetection(false), WithRetryPolicy(RetryPolicy{MaxAttempts: 1})}, opts...)
+	client, err := NewClient(context.Background(), url, "admin", "secret", true, nil, opts...)
+	require.NoError(t, err)
+	return client
+}
+
+func TestReboot(t *testing.T) {
+	server := newRebootServer(t, 3, false)
+	defer server.Close()
+	client := newRebootClient(t, server.URL)
+
+	require.NoError(t, client.Reboot(context.Background()))
+	require.NoError(t, client.WaitForReady(context.Background(), 5*time.Second, time.Millisecond))
+
+	assert.Equal(t, "/reboot.cgi", server.posts[1])
+	assert.Equal(t, "reboot", server.forms[1])
+	assert.Equal(t, "/login.cgi", server.posts[len(server.posts)-1], "the client should log in again")
+}
+
+func TestReboot_ConnectionDropped(t *testing.T) {
+	server := newRebootServer(t, 0, true)
+	defer server.Close()
+	client := newRebootClient(t, server.URL)
+
+	assert.NoError(t, client.Reboot(context.Background()))
+}
+
+func TestReboot_SavesPendingChanges(t *testing.T) {
+	server := newRebootServer(t, 0, false)
+	defer server.Close()
+	client := newRebootClient(t, server.URL, WithAutosaveMode(AutosaveBatch))
+	client.setPending(true)
+
+	require.NoError(t, client.Reboot(context.Background()))
+
+	assert.Equal(t, []string{"/login.cgi", "/save.cgi", "/reboot.cgi"}, server.posts)
+	assert.False(t, client.HasPendingChanges())
+}
+
+func TestReboot_DeviceAlert(t *testing.T) {
+	server := mockServerMock(`<script>window.location.href='alert.cgi?alertmsg=Reboot%20not%20allowed'</script>`, http.StatusOK)
+	defer server.Close()
+
+	client := &HRUIClient{URL: server.URL, HttpClient: server.Client()}
+	err := client.Reboot(context.Background())
+
+	var alert *DeviceAlertError
+	require.ErrorAs(t, err, &alert)
+	assert.Equal(t, "Reboot not allowed", alert.Message)
+}
+
+func TestFactoryReset(t *testing.T) {
+	server := newRebootServer(t, 0, false)
+	defer server.Close()
+	client := newRebootClient(t, server.URL, WithAutosaveMode(AutosaveBatch))
+	client.setPending(true)
+
+	require.NoError(t, client.FactoryReset(context.Background()))
+
+	assert.Equal(t, []string{"/login.cgi", "/reset.cgi"}, server.posts)
+	assert.Equal(t, "factory_default", server.forms[1])
+	assert.False(t, client.HasPendingChanges(), "pending changes are replaced by the defaults")
+}
+
+func TestWaitForReady_Timeout(t *testing.T) {
+	server := mockServerMock("", http.StatusServiceUnavailable)
+	defer server.Close()
+	jar, err := cookiejar.New(nil)
+	require.NoError(t, err)
+	httpClient := server.Client()
+	httpClient.Jar = jar
+	client := &HRUIClient{URL: server.URL, HttpClient: httpClient}
+
+	err = client.WaitForReady(context.Background(), 50*time.Millisecond, time.Millisecond)
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "switch not ready within 50ms")
+}
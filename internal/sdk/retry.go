@@ -0,0 +1,126 @@
+package sdk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"net"
+	"net/http"
+	"syscall"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// RetryPolicy controls how requests that fail with a transient error are retried.
+// The zero value disables retries.
+type RetryPolicy struct {
+	MaxAttempts int           // Total number of attempts, including the first one
+	BaseBackoff time.Duration // Delay before the first retry, doubled for every further retry
+	MaxBackoff  time.Duration // Upper bound for a single delay
+	Jitter      bool          // Randomize delays so parallel operations do not retry in lockstep
+}
+
+// DefaultRetryPolicy returns the retry policy used by NewClient.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts: 3,
+		BaseBackoff: 500 * time.Millisecond,
+		MaxBackoff:  5 * time.Second,
+		Jitter:      true,
+	}
+}
+
+// backoff returns the delay before the given retry (1 for the first retry).
+func (p RetryPolicy) backoff(retry int) time.Duration {
+	delay := p.BaseBackoff
+	for i := 1; i < retry && (p.MaxBackoff <= 0 || delay < p.MaxBackoff); i++ {
+		delay *= 2
+	}
+	if p.MaxBackoff > 0 && delay > p.MaxBackoff {
+		delay = p.MaxBackoff
+	}
+
+	// Equal jitter: keep half of the delay and randomize the other half.
+	if p.Jitter && delay > 1 {
+		half := delay / 2
+		//#nosec G404 -- jitter does not need a cryptographically secure source
+		delay = half + rand.N(half)
+	}
+
+	return delay
+}
+
+// httpStatusError is returned when the device answers with a non-2xx status code.
+type httpStatusError struct {
+	Endpoint   string
+	StatusCode int
+	Body       string
+}
+
+func (e *httpStatusError) Error() string {
+	return fmt.Sprintf("HTTP request to %s returned status %d: %s", e.Endpoint, e.StatusCode, e.Body)
+}
+
+// transientError marks an error as safe to retry even though it is not a network or status error.
+type transientError struct {
+	err error
+}
+
+func (e *transientError) Error() string { return e.err.Error() }
+func (e *transientError) Unwrap() error { return e.err }
+
+// isRetryable reports whether an error is likely to go away if the request is sent again:
+// dropped connections, timeouts and server-side failures while the switch is busy.
+func isRetryable(err error) bool {
+	var transient *transientError
+	if errors.As(err, &transient) {
+		return true
+	}
+
+	var statusErr *httpStatusError
+	if errors.As(err, &statusErr) {
+		return statusErr.StatusCode >= http.StatusInternalServerError ||
+			statusErr.StatusCode == http.StatusRequestTimeout ||
+			statusErr.StatusCode == http.StatusTooManyRequests
+	}
+
+	if errors.Is(err, syscall.ECONNRESET) || errors.Is(err, syscall.ECONNREFUSED) || errors.Is(err, syscall.EPIPE) ||
+		errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+		return true
+	}
+
+	var netErr net.Error
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
+
+// withRetry calls fn until it succeeds, fails with an error that is not retryable,
+// the retry policy is exhausted or the context is done.
+func (c *HRUIClient) withRetry(ctx context.Context, operation string, fn func() error) error {
+	policy := c.RetryPolicy
+
+	for attempt := 1; ; attempt++ {
+		err := fn()
+		if err == nil || attempt >= policy.MaxAttempts || ctx.Err() != nil || !isRetryable(err) {
+			return err
+		}
+
+		delay := policy.backoff(attempt)
+		tflog.Warn(ctx, "Retrying after transient failure", map[string]any{
+			"operation": operation,
+			"attempt":   attempt,
+			"delay":     delay.String(),
+			"error":     err.Error(),
+		})
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return fmt.Errorf("%w (retry aborted: %w)", err, ctx.Err())
+		case <-timer.C:
+		}
+	}
+}
@@ -0,0 +1,133 @@
+package sdk
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// flakyServer fails the first `failures` requests with the given status code.
+func flakyServer(failures, status int) (*httptest.Server, *int) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests <= failures {
+			w.WriteHeader(status)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte("Success"))
+	}))
+	return server, &requests
+}
+
+func testRetryPolicy() RetryPolicy {
+	return RetryPolicy{MaxAttempts: 3, BaseBackoff: time.Millisecond, MaxBackoff: 5 * time.Millisecond}
+}
+
+func TestRetry_GetRetriedOnServerError(t *testing.T) {
+	server, requests := flakyServer(2, http.StatusServiceUnavailable)
+	defer server.Close()
+
+	client := &HRUIClient{URL: server.URL, HttpClient: server.Client(), RetryPolicy: testRetryPolicy()}
+	body, err := client.Request(context.Background(), "GET", server.URL+"/port.cgi", nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, "Success", string(body))
+	require.Equal(t, 3, *requests)
+}
+
+func TestRetry_GetGivesUpAfterMaxAttempts(t *testing.T) {
+	server, requests := flakyServer(5, http.StatusInternalServerError)
+	defer server.Close()
+
+	client := &HRUIClient{URL: server.URL, HttpClient: server.Client(), RetryPolicy: testRetryPolicy()}
+	_, err := client.Request(context.Background(), "GET", server.URL+"/port.cgi", nil, nil)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "returned status 500")
+	require.Equal(t, 3, *requests)
+}
+
+func TestRetry_NotFoundIsNotRetried(t *testing.T) {
+	server, requests := flakyServer(5, http.StatusNotFound)
+	defer server.Close()
+
+	client := &HRUIClient{URL: server.URL, HttpClient: server.Client(), RetryPolicy: testRetryPolicy()}
+	_, err := client.Request(context.Background(), "GET", server.URL+"/port.cgi", nil, nil)
+	require.Error(t, err)
+	require.Equal(t, 1, *requests)
+}
+
+func TestRetry_FormRequestIsNotRetried(t *testing.T) {
+	server, requests := flakyServer(1, http.StatusServiceUnavailable)
+	defer server.Close()
+
+	client := &HRUIClient{URL: server.URL, HttpClient: server.Client(), RetryPolicy: testRetryPolicy()}
+	_, err := client.FormRequest(context.Background(), server.URL+"/mac.cgi?page=static", url.Values{"cmd": {"macstatic"}})
+	require.Error(t, err)
+	require.Equal(t, 1, *requests)
+}
+
+func TestRetry_IdempotentFormRequestIsRetried(t *testing.T) {
+	server, requests := flakyServer(1, http.StatusServiceUnavailable)
+	defer server.Close()
+
+	client := &HRUIClient{URL: server.URL, HttpClient: server.Client(), RetryPolicy: testRetryPolicy()}
+	_, err := client.idempotentFormRequest(context.Background(), server.URL+"/eee.cgi", url.Values{"cmd": {"loop"}})
+	require.NoError(t, err)
+	require.Equal(t, 2, *requests)
+}
+
+func TestRetry_ConnectionFailureIsRetried(t *testing.T) {
+	server, _ := flakyServer(0, http.StatusOK)
+	endpoint := server.URL + "/port.cgi"
+	server.Close()
+
+	attempts := 0
+	client := &HRUIClient{URL: server.URL, HttpClient: server.Client(), RetryPolicy: testRetryPolicy()}
+	err := client.withRetry(context.Background(), "test", func() error {
+		attempts++
+		_, err := client.do(context.Background(), "GET", endpoint, nil, nil)
+		return err
+	})
+	require.Error(t, err)
+	require.Equal(t, 3, attempts)
+}
+
+func TestRetry_StopsWhenContextIsDone(t *testing.T) {
+	server, requests := flakyServer(5, http.StatusServiceUnavailable)
+	defer server.Close()
+
+	policy := RetryPolicy{MaxAttempts: 5, BaseBackoff: time.Minute, MaxBackoff: time.Minute}
+	client := &HRUIClient{URL: server.URL, HttpClient: server.Client(), RetryPolicy: policy}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, err := client.Request(ctx, "GET", server.URL+"/port.cgi", nil, nil)
+	require.Error(t, err)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.Less(t, time.Since(start), 5*time.Second)
+	require.Equal(t, 1, *requests)
+}
+
+func TestRetryPolicy_Backoff(t *testing.T) {
+	policy := RetryPolicy{MaxAttempts: 5, BaseBackoff: 100 * time.Millisecond, MaxBackoff: 300 * time.Millisecond}
+
+	require.Equal(t, 100*time.Millisecond, policy.backoff(1))
+	require.Equal(t, 200*time.Millisecond, policy.backoff(2))
+	require.Equal(t, 300*time.Millisecond, policy.backoff(3))
+	require.Equal(t, 300*time.Millisecond, policy.backoff(10))
+
+	policy.Jitter = true
+	for retry := 1; retry <= 4; retry++ {
+		delay := policy.backoff(retry)
+		require.GreaterOrEqual(t, delay, 50*time.Millisecond)
+		require.LessOrEqual(t, delay, 300*time.Millisecond)
+	}
+}
@@ -0,0 +1,92 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+// SystemSettings represents the writable identification fields of the switch.
+type SystemSettings struct {
+	Name     string
+	Location string
+	Contact  string
+}
+
+// Form fields of the system settings on info.cgi.
+const (
+	systemNameField     = "sys_name"
+	systemLocationField = "sys_location"
+	systemContactField  = "sys_contact"
+)
+
+// systemSettingsFeature names the system settings in ErrUnsupportedFeature errors.
+const systemSettingsFeature = "editing the system name, location and contact"
+
+// GetSystemSettings retrieves the system name, location and contact from info.cgi.
+// It returns ErrUnsupportedFeature if the firmware does not expose the fields, see
+// Capabilities.SystemSettings.
+func (c *HRUIClient) GetSystemSettings(ctx context.Context) (*SystemSettings, error) {
+	if err := c.requireCapability(c.Capabilities().SystemSettings, systemSettingsFeature); err != nil {
+		return nil, err
+	}
+
+	systemInfoURL := fmt.Sprintf("%s/info.cgi", c.BaseURL())
+
+	respBody, err := c.Request(ctx, "GET", systemInfoURL, nil, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch System Settings from HRUI: %w", err)
+	}
+
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(string(respBody)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse System Settings HTML output: %w", err)
+	}
+
+	settings, err := parseSystemSettings(doc)
+	if err != nil {
+		return nil, c.optionalPageError(err, c.Capabilities().SystemSettings, systemSettingsFeature)
+	}
+	return settings, nil
+}
+
+// SetSystemSettings updates the system name, location and contact.
+// It returns ErrUnsupportedFeature if the firmware does not expose the fields.
+func (c *HRUIClient) SetSystemSettings(ctx context.Context, settings *SystemSettings) error {
+	if err := c.requireCapability(c.Capabilities().SystemSettings, systemSettingsFeature); err != nil {
+		return err
+	}
+
+	form := url.Values{}
+	form.Set(systemNameField, settings.Name)
+	form.Set(systemLocationField, settings.Location)
+	form.Set(systemContactField, settings.Contact)
+	form.Set("cmd", "info")
+
+	systemInfoURL := fmt.Sprintf("%s/info.cgi", c.BaseURL())
+	_, err := c.idempotentFormRequest(ctx, systemInfoURL, form)
+	if err != nil {
+		return fmt.Errorf("failed to update System Settings: %w", err)
+	}
+
+	return nil
+}
+
+// parseSystemSettings extracts the system settings form fields from info.cgi.
+// It returns a ParseError if the page has no system name field.
+func parseSystemSettings(doc *goquery.Document) (*SystemSettings, error) {
+	selector := fmt.Sprintf("input[name='%s']", systemNameField)
+	name := doc.Find(selector)
+	if name.Length() == 0 {
+		return nil, &ParseError{Page: "/info.cgi", Selector: selector}
+	}
+
+	return &SystemSettings{
+		Name:     name.AttrOr("value", ""),
+		Location: doc.Find(fmt.Sprintf("input[name='%s']", systemLocationField)).AttrOr("value", ""),
+		Contact:  doc.Find(fmt.Sprintf("input[name='%s']", systemContactField)).AttrOr("value", ""),
+	}, nil
+}
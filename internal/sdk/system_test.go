@@ -0,0 +1,120 @@
+package sdk
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const systemSettingsHTML = `<form method="post" action="/info.cgi">
+	<input type="text" name="sys_name" value="core-sw1">
+	<input type="text" name="sys_location" value="Rack 4">
+	<input type="text" name="sys_contact" value="noc@example.com">
+	<input type="hidden" name="cmd" value="info">
+</form>`
+
+// withSystemSettings returns a client for firmware with the system settings form. No recorded
+// firmware has it, so the capability is off by default.
+func withSystemSettings(server *httptest.Server) *HRUIClient {
+	client := &HRUIClient{URL: server.URL, HttpClient: server.Client()}
+	client.setCapabilities(Capabilities{SystemSettings: true})
+	return client
+}
+
+func TestGetSystemSettings(t *testing.T) {
+	server := mockServerMock(systemSettingsHTML, http.StatusOK)
+	defer server.Close()
+
+	client := withSystemSettings(server)
+	settings, err := client.GetSystemSettings(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, &SystemSettings{Name: "core-sw1", Location: "Rack 4", Contact: "noc@example.com"}, settings)
+}
+
+func TestGetSystemSettings_Unsupported(t *testing.T) {
+	requested := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requested = true
+	}))
+	defer server.Close()
+
+	for _, firmware := range []string{"V1.9", "V1.9.1", "V2.0"} {
+		client := &HRUIClient{URL: server.URL, HttpClient: server.Client()}
+		client.setCapabilities(capabilitiesFor(firmware, "V1.0"))
+		_, err := client.GetSystemSettings(context.Background())
+
+		assert.ErrorIs(t, err, ErrUnsupportedFeature, firmware)
+	}
+	assert.False(t, requested, "info.cgi has no settings form on any known firmware")
+}
+
+func TestGetSystemSettings_UnknownFirmwareWithoutForm(t *testing.T) {
+	server := mockServerMock(`<table><tr><th>Firmware Version</th><td>V2.0</td></tr></table>`, http.StatusOK)
+	defer server.Close()
+
+	client := withSystemSettings(server)
+	_, err := client.GetSystemSettings(context.Background())
+
+	assert.ErrorIs(t, err, ErrUnsupportedFeature)
+}
+
+func TestGetSystemSettings_UnexpectedLayout(t *testing.T) {
+	server := mockServerMock(`<table></table>`, http.StatusOK)
+	defer server.Close()
+
+	// Firmware known to have the fields makes a page without them a layout problem.
+	client := &HRUIClient{URL: server.URL, HttpClient: server.Client()}
+	client.setCapabilities(Capabilities{Known: true, FirmwareVersion: "V2.0", SystemSettings: true})
+	_, err := client.GetSystemSettings(context.Background())
+
+	var parseErr *ParseError
+	require.ErrorAs(t, err, &parseErr)
+	assert.NotErrorIs(t, err, ErrUnsupportedFeature)
+}
+
+func TestSetSystemSettings(t *testing.T) {
+	var receivedForm url.Values
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			if err := r.ParseForm(); err != nil {
+				t.Errorf("failed to parse form data: %v", err)
+			}
+			receivedForm = r.PostForm
+		}
+		_, _ = w.Write([]byte(systemSettingsHTML))
+	}))
+	defer server.Close()
+
+	client := withSystemSettings(server)
+	err := client.SetSystemSettings(context.Background(), &SystemSettings{Name: "edge-sw2", Location: "Closet", Contact: ""})
+
+	require.NoError(t, err)
+	assert.Equal(t, "edge-sw2", receivedForm.Get("sys_name"))
+	assert.Equal(t, "Closet", receivedForm.Get("sys_location"))
+	assert.Equal(t, "", receivedForm.Get("sys_contact"))
+	assert.Equal(t, "info", receivedForm.Get("cmd"))
+}
+
+func TestSetSystemSettings_Unsupported(t *testing.T) {
+	posted := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			posted = true
+		}
+		_, _ = w.Write([]byte(`<table></table>`))
+	}))
+	defer server.Close()
+
+	client := &HRUIClient{URL: server.URL, HttpClient: server.Client()}
+	client.setCapabilities(capabilitiesFor("V1.9.1", "V1.0"))
+	err := client.SetSystemSettings(context.Background(), &SystemSettings{Name: "edge-sw2"})
+
+	assert.ErrorIs(t, err, ErrUnsupportedFeature)
+	assert.False(t, posted, "settings must not be posted to firmware without the form")
+}
@@ -0,0 +1,115 @@
+package sdk
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRequestTimeout_AppliesPerAttempt(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if requests.Add(1) == 1 {
+			// Outlive the request timeout, then answer the retry immediately.
+			time.Sleep(200 * time.Millisecond)
+		}
+		_, _ = w.Write([]byte("Success"))
+	}))
+	defer server.Close()
+
+	client := &HRUIClient{
+		URL:            server.URL,
+		HttpClient:     server.Client(),
+		RequestTimeout: 50 * time.Millisecond,
+		RetryPolicy:    RetryPolicy{MaxAttempts: 2, BaseBackoff: time.Millisecond, MaxBackoff: time.Millisecond},
+	}
+
+	body, err := client.Request(context.Background(), "GET", server.URL+"/port.cgi", nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, "Success", string(body))
+	require.Equal(t, int32(2), requests.Load())
+}
+
+func TestRequestTimeout_Exceeded(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(200 * time.Millisecond)
+	}))
+	defer server.Close()
+
+	client := &HRUIClient{URL: server.URL, HttpClient: server.Client(), RequestTimeout: 50 * time.Millisecond}
+	_, err := client.Request(context.Background(), "GET", server.URL+"/port.cgi", nil, nil)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestSetSTPSettingsAsync_DoesNotChangeClientTimeout(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			// The STP CGI never answers; hold the request past the scoped deadline.
+			time.Sleep(stpApplyTimeout + 500*time.Millisecond)
+			return
+		}
+		time.Sleep(2500 * time.Millisecond)
+		_, _ = w.Write([]byte("Success"))
+	}))
+	defer server.Close()
+
+	client := &HRUIClient{URL: server.URL, HttpClient: server.Client(), RequestTimeout: 10 * time.Second}
+
+	start := time.Now()
+	err := client.SetSTPSettingsAsync(context.Background(), &STPGlobalSettings{ForceVersion: "RSTP"})
+	require.NoError(t, err)
+	require.Less(t, time.Since(start), stpApplyTimeout+time.Second)
+	require.Zero(t, client.HttpClient.Timeout)
+
+	// Requests after the STP update are not bound by its short deadline.
+	body, err := client.Request(context.Background(), "GET", server.URL+"/loop.cgi?page=stp_global", nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, "Success", string(body))
+}
+
+func TestSetSTPSettingsAsync_DeadlineExcludesQueue(t *testing.T) {
+	var posts, saves atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/save.cgi":
+			saves.Add(1)
+		case r.Method == http.MethodPost:
+			posts.Add(1)
+		default:
+			// Occupy the only request slot for longer than the STP deadline.
+			time.Sleep(stpApplyTimeout + 500*time.Millisecond)
+		}
+		_, _ = w.Write([]byte("Success"))
+	}))
+	defer server.Close()
+
+	client := &HRUIClient{URL: server.URL, HttpClient: server.Client(), MaxConcurrentRequests: 1, Autosave: true}
+
+	done := make(chan error, 1)
+	go func() {
+		_, err := client.Request(context.Background(), "GET", server.URL+"/loop.cgi?page=stp_global", nil, nil)
+		done <- err
+	}()
+	time.Sleep(100 * time.Millisecond)
+
+	// The update waits for the slot, then is sent and saved.
+	err := client.SetSTPSettingsAsync(context.Background(), &STPGlobalSettings{ForceVersion: "RSTP"})
+	require.NoError(t, err)
+	require.NoError(t, <-done)
+	require.Equal(t, int32(1), posts.Load())
+	require.Equal(t, int32(1), saves.Load())
+}
+
+func TestSetSTPSettingsAsync_Error(t *testing.T) {
+	server := mockServerMock("", http.StatusInternalServerError)
+	defer server.Close()
+
+	client := &HRUIClient{URL: server.URL, HttpClient: server.Client()}
+	err := client.SetSTPSettingsAsync(context.Background(), &STPGlobalSettings{ForceVersion: "RSTP"})
+	require.ErrorContains(t, err, "failed to update STP global settings")
+}
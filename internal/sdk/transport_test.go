@@ -0,0 +1,81 @@
+package sdk
+
+import (
+	"context"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newTLSLoginServer() *httptest.Server {
+	return httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte("Login successful"))
+	}))
+}
+
+func TestNewClient_TLSWithCustomCA(t *testing.T) {
+	server := newTLSLoginServer()
+	defer server.Close()
+
+	caPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	tlsConfig, err := NewTLSConfig(TLSOptions{CACertPEM: caPEM})
+	require.NoError(t, err)
+
+	_, err = NewClient(context.Background(), server.URL, "user", "pass", false, nil,
+		WithTLSConfig(tlsConfig), WithFirmwareDetection(false))
+	require.NoError(t, err)
+}
+
+func TestNewClient_TLSUntrustedCertificate(t *testing.T) {
+	server := newTLSLoginServer()
+	defer server.Close()
+
+	_, err := NewClient(context.Background(), server.URL, "user", "pass", false, nil,
+		WithFirmwareDetection(false), WithRetryPolicy(RetryPolicy{MaxAttempts: 1}))
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "certificate")
+}
+
+func TestNewClient_TLSInsecureSkipVerify(t *testing.T) {
+	server := newTLSLoginServer()
+	defer server.Close()
+
+	tlsConfig, err := NewTLSConfig(TLSOptions{InsecureSkipVerify: true})
+	require.NoError(t, err)
+
+	_, err = NewClient(context.Background(), server.URL, "user", "pass", false, nil,
+		WithTLSConfig(tlsConfig), WithFirmwareDetection(false))
+	require.NoError(t, err)
+}
+
+func TestNewTLSConfig_Invalid(t *testing.T) {
+	_, err := NewTLSConfig(TLSOptions{CACertPEM: []byte("not a certificate")})
+	require.Error(t, err)
+
+	_, err = NewTLSConfig(TLSOptions{ClientCertPEM: []byte("cert")})
+	require.ErrorContains(t, err, "failed to load client certificate")
+}
+
+func TestNewClient_ProxyURL(t *testing.T) {
+	proxied := 0
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		proxied++
+		require.Equal(t, "switch.invalid", r.URL.Host)
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte("Login successful"))
+	}))
+	defer proxy.Close()
+
+	proxyURL, err := url.Parse(proxy.URL)
+	require.NoError(t, err)
+
+	_, err = NewClient(context.Background(), "http://switch.invalid", "user", "pass", false, nil,
+		WithProxyURL(proxyURL), WithFirmwareDetection(false))
+	require.NoError(t, err)
+	require.Positive(t, proxied)
+}
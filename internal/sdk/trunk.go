@@ -2,7 +2,6 @@ package sdk
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"net/url"
 	"strconv"
@@ -20,7 +19,7 @@ type TrunkConfig struct {
 // ListAvailableTrunks fetches available Trunks on the device.
 func (c *HRUIClient) ListAvailableTrunks(ctx context.Context) ([]TrunkConfig, error) {
 	// Fetch the HTML page
-	endpoint := c.URL + "/trunk.cgi?page=group"
+	endpoint := c.BaseURL() + "/trunk.cgi?page=group"
 	respBody, err := c.Request(ctx, "GET", endpoint, nil, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch trunk page: %w", err)
@@ -50,6 +49,12 @@ func (c *HRUIClient) ListAvailableTrunks(ctx context.Context) ([]TrunkConfig, er
 
 // ConfigureTrunk sends configuration for a Trunk.
 func (c *HRUIClient) ConfigureTrunk(ctx context.Context, config *TrunkConfig) error {
+	if config.Type == "LACP" {
+		if err := c.requireCapability(c.Capabilities().LACP, "LACP trunking"); err != nil {
+			return err
+		}
+	}
+
 	form := url.Values{}
 
 	// Set trunk group ID and type
@@ -64,7 +69,7 @@ func (c *HRUIClient) ConfigureTrunk(ctx context.Context, config *TrunkConfig) er
 	form.Set("cmd", "trunk")
 
 	// Endpoint for creating/modifying trunk groups
-	endpoint := c.URL + "/trunk.cgi?page=group"
+	endpoint := c.BaseURL() + "/trunk.cgi?page=group"
 
 	// Use FormRequest to send the form and handle errors
 	_, err := c.FormRequest(ctx, endpoint, form)
@@ -93,7 +98,7 @@ func (c *HRUIClient) DeleteTrunk(ctx context.Context, id int) error {
 	form.Set("id", strconv.Itoa(id))
 	form.Set("cmd", "group_remove")
 
-	endpoint := c.URL + "/trunk.cgi?page=group_remove"
+	endpoint := c.BaseURL() + "/trunk.cgi?page=group_remove"
 	_, err := c.FormRequest(ctx, endpoint, form)
 
 	return err
@@ -101,7 +106,7 @@ func (c *HRUIClient) DeleteTrunk(ctx context.Context, id int) error {
 
 // GetTrunk fetches details of a configured Trunk by its ID.
 func (c *HRUIClient) GetTrunk(ctx context.Context, id int) (*TrunkConfig, error) {
-	endpoint := c.URL + "/trunk.cgi?page=group"
+	endpoint := c.BaseURL() + "/trunk.cgi?page=group"
 	respBody, err := c.Request(ctx, "GET", endpoint, nil, nil)
 	if err != nil {
 		return nil, err
@@ -168,7 +173,7 @@ func (c *HRUIClient) GetTrunk(ctx context.Context, id int) (*TrunkConfig, error)
 	})
 
 	if trunk == nil {
-		return nil, errors.New("trunk not found")
+		return nil, fmt.Errorf("trunk %d %w", id, ErrNotFound)
 	}
 
 	return trunk, nil
@@ -176,7 +181,7 @@ func (c *HRUIClient) GetTrunk(ctx context.Context, id int) (*TrunkConfig, error)
 
 // ListConfiguredTrunks fetches configured Trunks from the device.
 func (c *HRUIClient) ListConfiguredTrunks(ctx context.Context) ([]TrunkConfig, error) {
-	endpoint := c.URL + "/trunk.cgi?page=group"
+	endpoint := c.BaseURL() + "/trunk.cgi?page=group"
 	respBody, err := c.Request(ctx, "GET", endpoint, nil, nil)
 	if err != nil {
 		return nil, err
@@ -31,6 +31,13 @@ func (c *HRUIClient) AddVLAN(ctx context.Context, vlan *Vlan) error {
 		return fmt.Errorf("HRUIClient is nil")
 	}
 
+	return c.exclusive(ctx, func(ctx context.Context) error {
+		return c.addVLAN(ctx, vlan)
+	})
+}
+
+// addVLAN implements AddVLAN while holding the operation lock.
+func (c *HRUIClient) addVLAN(ctx context.Context, vlan *Vlan) error {
 	portConfigs, err := c.ListPortVLANConfigs(ctx)
 	if err != nil {
 		return fmt.Errorf("failed to get port VLAN configurations: %w", err)
@@ -52,8 +59,8 @@ func (c *HRUIClient) AddVLAN(ctx context.Context, vlan *Vlan) error {
 		form.Set(fmt.Sprintf("vlanPort_%d", portConfig.PortID), formValue)
 	}
 
-	vlanURL := fmt.Sprintf("%s/vlan.cgi?page=static", c.URL)
-	_, err = c.FormRequest(ctx, vlanURL, form)
+	vlanURL := fmt.Sprintf("%s/vlan.cgi?page=static", c.BaseURL())
+	_, err = c.idempotentFormRequest(ctx, vlanURL, form)
 	if err != nil {
 		return fmt.Errorf("failed to create/update VLAN: %w", err)
 	}
@@ -84,7 +91,7 @@ func (c *HRUIClient) GetVLAN(ctx context.Context, vlanID int) (*Vlan, error) {
 		}
 	}
 
-	return nil, fmt.Errorf("VLAN with ID %d not found", vlanID)
+	return nil, fmt.Errorf("VLAN with ID %d %w", vlanID, ErrNotFound)
 }
 
 // ListVLANs fetches the list of VLANs using port names.
@@ -93,7 +100,7 @@ func (c *HRUIClient) ListVLANs(ctx context.Context) ([]*Vlan, error) {
 		return nil, fmt.Errorf("HRUIClient is nil")
 	}
 
-	vlanURL := fmt.Sprintf("%s/vlan.cgi?page=static", c.URL)
+	vlanURL := fmt.Sprintf("%s/vlan.cgi?page=static", c.BaseURL())
 	respBody, err := c.Request(ctx, "GET", vlanURL, nil, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch VLAN configuration from HRUI: %w", err)
@@ -104,6 +111,11 @@ func (c *HRUIClient) ListVLANs(ctx context.Context) ([]*Vlan, error) {
 		return nil, fmt.Errorf("failed to parse VLAN HTML output: %w", err)
 	}
 
+	return c.parsers().vlans.parse(doc)
+}
+
+// parseVLANTable extracts the VLANs listed in the status form of the static VLAN page.
+func parseVLANTable(doc *goquery.Document) ([]*Vlan, error) {
 	var vlans []*Vlan
 
 	doc.Find("form[name='formVlanStatus'] table tr").Each(func(i int, s *goquery.Selection) {
@@ -131,9 +143,9 @@ func (c *HRUIClient) ListVLANs(ctx context.Context) ([]*Vlan, error) {
 		vlan.Name = strings.TrimSpace(s.Find("td:nth-child(2)").Text())
 
 		// Extract port configurations
-		vlan.MemberPorts = c.ParsePortRange(strings.TrimSpace(s.Find("td:nth-child(3)").Text()))
-		vlan.TaggedPorts = c.ParsePortRange(strings.TrimSpace(s.Find("td:nth-child(4)").Text()))
-		vlan.UntaggedPorts = c.ParsePortRange(strings.TrimSpace(s.Find("td:nth-child(5)").Text()))
+		vlan.MemberPorts = parsePortRange(strings.TrimSpace(s.Find("td:nth-child(3)").Text()))
+		vlan.TaggedPorts = parsePortRange(strings.TrimSpace(s.Find("td:nth-child(4)").Text()))
+		vlan.UntaggedPorts = parsePortRange(strings.TrimSpace(s.Find("td:nth-child(5)").Text()))
 
 		vlans = append(vlans, vlan)
 	})
@@ -150,7 +162,7 @@ func (c *HRUIClient) RemoveVLAN(ctx context.Context, vlanID int) error {
 	form := url.Values{}
 	form.Set(fmt.Sprintf("remove_%d", vlanID), "on")
 
-	deleteURL := fmt.Sprintf("%s/vlan.cgi?page=getRmvVlanEntry", c.URL)
+	deleteURL := fmt.Sprintf("%s/vlan.cgi?page=getRmvVlanEntry", c.BaseURL())
 
 	_, err := c.FormRequest(ctx, deleteURL, form)
 	if err != nil {
@@ -166,11 +178,11 @@ func (c *HRUIClient) ListPortVLANConfigs(ctx context.Context) ([]*PortVLANConfig
 		return nil, fmt.Errorf("HRUIClient is nil")
 	}
 
-	if c.URL == "" {
+	if c.BaseURL() == "" {
 		return nil, fmt.Errorf("HRUIClient.URL is empty")
 	}
 
-	portVLANURL := fmt.Sprintf("%s/vlan.cgi?page=port_based", c.URL)
+	portVLANURL := fmt.Sprintf("%s/vlan.cgi?page=port_based", c.BaseURL())
 
 	respBody, err := c.Request(ctx, "GET", portVLANURL, nil, nil)
 	if err != nil {
@@ -234,7 +246,7 @@ func (c *HRUIClient) GetPortVLANConfig(ctx context.Context, port int) (*PortVLAN
 		}
 	}
 
-	return nil, fmt.Errorf("port %d not found in %d ports", port, len(configs))
+	return nil, fmt.Errorf("port %d %w in %d ports", port, ErrNotFound, len(configs))
 }
 
 // SetPortVLANConfig sets the VLAN configuration for a specific port on the switch.
@@ -243,6 +255,13 @@ func (c *HRUIClient) SetPortVLANConfig(ctx context.Context, config *PortVLANConf
 		return fmt.Errorf("HRUIClient is nil")
 	}
 
+	return c.exclusive(ctx, func(ctx context.Context) error {
+		return c.setPortVLANConfig(ctx, config)
+	})
+}
+
+// setPortVLANConfig implements SetPortVLANConfig while holding the operation lock.
+func (c *HRUIClient) setPortVLANConfig(ctx context.Context, config *PortVLANConfig) error {
 	if config == nil {
 		return fmt.Errorf("PortVLANConfig is nil")
 	}
@@ -286,8 +305,8 @@ func (c *HRUIClient) SetPortVLANConfig(ctx context.Context, config *PortVLANConf
 	form.Set("vlan_accept_frame_type", frameTypeValue)
 
 	// Submit the form
-	portVLANURL := fmt.Sprintf("%s/vlan.cgi?page=port_based", c.URL)
-	_, err := c.FormRequest(ctx, portVLANURL, form)
+	portVLANURL := fmt.Sprintf("%s/vlan.cgi?page=port_based", c.BaseURL())
+	_, err := c.idempotentFormRequest(ctx, portVLANURL, form)
 	if err != nil {
 		return fmt.Errorf("failed to set port VLAN config: %w", err)
 	}
@@ -295,7 +314,12 @@ func (c *HRUIClient) SetPortVLANConfig(ctx context.Context, config *PortVLANConf
 	return nil
 }
 
+// ParsePortRange expands a port list such as "1-3,5,Trunk2" into port names.
 func (c *HRUIClient) ParsePortRange(portStr string) []string {
+	return parsePortRange(portStr)
+}
+
+func parsePortRange(portStr string) []string {
 	ports := []string{}
 	entries := strings.Split(portStr, ",")
 
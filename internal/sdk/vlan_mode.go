@@ -0,0 +1,219 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+// VLANMode is the global VLAN mode of the switch.
+type VLANMode string
+
+const (
+	// VLANMode8021Q forwards frames according to the 802.1Q VLANs and port PVIDs.
+	VLANMode8021Q VLANMode = "802.1q"
+	// VLANModePortBased forwards frames only between ports of the same port-based VLAN group.
+	VLANModePortBased VLANMode = "port_based"
+)
+
+// vlanModeValues maps the option values of the VLAN mode selector to modes.
+var vlanModeValues = map[string]VLANMode{
+	"0": VLANMode8021Q,
+	"1": VLANModePortBased,
+}
+
+// PortBasedVLAN is a group of ports that may exchange frames in port-based VLAN mode.
+type PortBasedVLAN struct {
+	ID          int
+	MemberPorts []string
+}
+
+// Pages of the VLAN mode selector and the port-based VLAN groups. Note that
+// vlan.cgi?page=port_based is the PVID page of 802.1Q mode.
+const (
+	vlanModePage         = "vlan.cgi?page=vlan_mode"
+	portBasedVLANPage    = "vlan.cgi?page=port_vlan"
+	portBasedVLANDelPage = "vlan.cgi?page=port_vlan_del"
+)
+
+// Features of the VLAN mode pages in ErrUnsupportedFeature errors.
+const (
+	vlanModeFeature      = "selecting the VLAN mode"
+	portBasedVLANFeature = "port-based VLANs"
+)
+
+// GetVLANMode retrieves the global VLAN mode.
+// It returns ErrUnsupportedFeature if the firmware has no VLAN mode selector, see
+// Capabilities.VLANMode.
+func (c *HRUIClient) GetVLANMode(ctx context.Context) (VLANMode, error) {
+	if err := c.requireCapability(c.Capabilities().VLANMode, vlanModeFeature); err != nil {
+		return "", err
+	}
+
+	respBody, err := c.Request(ctx, "GET", fmt.Sprintf("%s/%s", c.BaseURL(), vlanModePage), nil, nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to fetch VLAN mode from HRUI: %w", err)
+	}
+
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(string(respBody)))
+	if err != nil {
+		return "", fmt.Errorf("failed to parse VLAN mode HTML output: %w", err)
+	}
+
+	mode, err := c.parsers().vlanMode.parse(doc)
+	if err != nil {
+		return "", c.optionalPageError(err, c.Capabilities().VLANMode, vlanModeFeature)
+	}
+	return mode, nil
+}
+
+// SetVLANMode switches the global VLAN mode. The switch may reset the VLAN configuration of
+// the previous mode. It returns ErrUnsupportedFeature if the firmware has no VLAN mode selector.
+func (c *HRUIClient) SetVLANMode(ctx context.Context, mode VLANMode) error {
+	value := ""
+	for v, m := range vlanModeValues {
+		if m == mode {
+			value = v
+		}
+	}
+	if value == "" {
+		return fmt.Errorf("invalid VLAN mode: %s", mode)
+	}
+
+	if err := c.requireCapability(c.Capabilities().VLANMode, vlanModeFeature); err != nil {
+		return err
+	}
+
+	form := url.Values{}
+	form.Set("vlan_mode", value)
+
+	_, err := c.idempotentFormRequest(ctx, fmt.Sprintf("%s/%s", c.BaseURL(), vlanModePage), form)
+	if err != nil {
+		return fmt.Errorf("failed to set VLAN mode: %w", err)
+	}
+
+	return nil
+}
+
+// parseVLANMode reads the selected option of the VLAN mode selector.
+func parseVLANMode(doc *goquery.Document) (VLANMode, error) {
+	selector := "select[name='vlan_mode']"
+	selection := doc.Find(selector)
+	if selection.Length() == 0 {
+		return "", &ParseError{Page: "/" + vlanModePage, Selector: selector}
+	}
+
+	value, _ := selection.Find("option[selected]").Attr("value")
+	mode, ok := vlanModeValues[strings.TrimSpace(value)]
+	if !ok {
+		return "", fmt.Errorf("unknown VLAN mode option %q", value)
+	}
+	return mode, nil
+}
+
+// ListPortBasedVLANs fetches the port-based VLAN groups. Like the other port-based VLAN
+// functions, it returns ErrUnsupportedFeature if the firmware has no VLAN mode selector.
+func (c *HRUIClient) ListPortBasedVLANs(ctx context.Context) ([]*PortBasedVLAN, error) {
+	if err := c.requireCapability(c.Capabilities().VLANMode, portBasedVLANFeature); err != nil {
+		return nil, err
+	}
+
+	respBody, err := c.Request(ctx, "GET", fmt.Sprintf("%s/%s", c.BaseURL(), portBasedVLANPage), nil, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch port-based VLANs from HRUI: %w", err)
+	}
+
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(string(respBody)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse port-based VLAN HTML output: %w", err)
+	}
+
+	return c.parsers().portBasedVLANs.parse(doc)
+}
+
+// GetPortBasedVLAN fetches a single port-based VLAN group by its ID.
+func (c *HRUIClient) GetPortBasedVLAN(ctx context.Context, id int) (*PortBasedVLAN, error) {
+	vlans, err := c.ListPortBasedVLANs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, vlan := range vlans {
+		if vlan.ID == id {
+			return vlan, nil
+		}
+	}
+
+	return nil, fmt.Errorf("port-based VLAN %d %w", id, ErrNotFound)
+}
+
+// SetPortBasedVLAN creates or updates a port-based VLAN group. Ports not listed are removed from the group.
+func (c *HRUIClient) SetPortBasedVLAN(ctx context.Context, vlan *PortBasedVLAN) error {
+	if err := c.requireCapability(c.Capabilities().VLANMode, portBasedVLANFeature); err != nil {
+		return err
+	}
+
+	form := url.Values{}
+	form.Set("vid", strconv.Itoa(vlan.ID))
+
+	for _, portName := range vlan.MemberPorts {
+		portID, err := c.GetPortByName(ctx, portName)
+		if err != nil {
+			return fmt.Errorf("failed to resolve port ID for port '%s': %w", portName, err)
+		}
+		form.Set(fmt.Sprintf("vlanPort_%d", portID), "1")
+	}
+
+	_, err := c.idempotentFormRequest(ctx, fmt.Sprintf("%s/%s", c.BaseURL(), portBasedVLANPage), form)
+	if err != nil {
+		return fmt.Errorf("failed to set port-based VLAN %d: %w", vlan.ID, err)
+	}
+
+	return nil
+}
+
+// RemovePortBasedVLAN deletes a port-based VLAN group.
+func (c *HRUIClient) RemovePortBasedVLAN(ctx context.Context, id int) error {
+	if err := c.requireCapability(c.Capabilities().VLANMode, portBasedVLANFeature); err != nil {
+		return err
+	}
+
+	form := url.Values{}
+	form.Set(fmt.Sprintf("remove_%d", id), "on")
+
+	_, err := c.FormRequest(ctx, fmt.Sprintf("%s/%s", c.BaseURL(), portBasedVLANDelPage), form)
+	if err != nil {
+		return fmt.Errorf("failed to delete port-based VLAN %d: %w", id, err)
+	}
+
+	return nil
+}
+
+// parsePortBasedVLANTable extracts the groups listed in the status form of the port-based VLAN page.
+func parsePortBasedVLANTable(doc *goquery.Document) ([]*PortBasedVLAN, error) {
+	selector := "form[name='formPortVlanStatus'] table"
+	table := doc.Find(selector)
+	if table.Length() == 0 {
+		return nil, &ParseError{Page: "/" + portBasedVLANPage, Selector: selector}
+	}
+
+	vlans := []*PortBasedVLAN{}
+	table.Find("tr").Slice(1, goquery.ToEnd).Each(func(_ int, s *goquery.Selection) {
+		id, err := strconv.Atoi(strings.TrimSpace(s.Find("td:nth-child(1)").Text()))
+		if err != nil {
+			return
+		}
+		vlans = append(vlans, &PortBasedVLAN{
+			ID:          id,
+			MemberPorts: parsePortRange(strings.TrimSpace(s.Find("td:nth-child(2)").Text())),
+		})
+	})
+
+	sort.Slice(vlans, func(i, j int) bool { return vlans[i].ID < vlans[j].ID })
+	return vlans, nil
+}
@@ -0,0 +1,188 @@
+package sdk
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const vlanModeHTML = `<form method="post" action="/vlan.cgi?page=vlan_mode">
+	<select name="vlan_mode">
+		<option value="0">802.1Q VLAN
+		<option value="1" selected>Port Based VLAN
+	</select>
+</form>`
+
+const portBasedVLANHTML = `<form name="formPortVlanStatus">
+	<table border="1">
+		<tr><th>VLAN</th><th>Member Ports</th></tr>
+		<tr><td>2</td><td>5-6</td></tr>
+		<tr><td>1</td><td>1-4,Trunk1</td></tr>
+	</table>
+</form>`
+
+const portBasedVLANPortsHTML = `<select name="portid">
+	<option value="0">Port 1</option>
+	<option value="1">Port 2</option>
+	<option value="2">Port 3</option>
+</select>`
+
+// withVLANMode returns a client for firmware with the VLAN mode selector. No recorded
+// firmware has it, so the capability is off by default.
+func withVLANMode(server *httptest.Server) *HRUIClient {
+	client := &HRUIClient{URL: server.URL, HttpClient: server.Client()}
+	client.setCapabilities(Capabilities{VLANMode: true})
+	return client
+}
+
+func TestGetVLANMode(t *testing.T) {
+	server := mockServerMock(vlanModeHTML, http.StatusOK)
+	defer server.Close()
+
+	client := withVLANMode(server)
+	mode, err := client.GetVLANMode(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, VLANModePortBased, mode)
+}
+
+func TestGetVLANMode_Unsupported(t *testing.T) {
+	requested := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requested = true
+	}))
+	defer server.Close()
+
+	for _, firmware := range []string{"V1.9", "V1.9.1", "V2.0"} {
+		client := &HRUIClient{URL: server.URL, HttpClient: server.Client()}
+		client.setCapabilities(capabilitiesFor(firmware, "V1.0"))
+		_, err := client.GetVLANMode(context.Background())
+		assert.ErrorIs(t, err, ErrUnsupportedFeature, firmware)
+
+		_, err = client.ListPortBasedVLANs(context.Background())
+		assert.ErrorIs(t, err, ErrUnsupportedFeature, firmware)
+		assert.ErrorIs(t, client.SetPortBasedVLAN(context.Background(), &PortBasedVLAN{ID: 2}), ErrUnsupportedFeature, firmware)
+		assert.ErrorIs(t, client.RemovePortBasedVLAN(context.Background(), 2), ErrUnsupportedFeature, firmware)
+	}
+	assert.False(t, requested, "no known firmware has the VLAN mode pages")
+}
+
+func TestGetVLANMode_UnknownFirmwareWithoutSelector(t *testing.T) {
+	server := mockServerMock(`<form name="formVlanStatus"><table></table></form>`, http.StatusOK)
+	defer server.Close()
+
+	client := withVLANMode(server)
+	_, err := client.GetVLANMode(context.Background())
+
+	assert.ErrorIs(t, err, ErrUnsupportedFeature)
+}
+
+func TestGetVLANMode_UnexpectedLayout(t *testing.T) {
+	server := mockServerMock(`<form name="formVlanStatus"><table></table></form>`, http.StatusOK)
+	defer server.Close()
+
+	// Firmware known to have the selector, so a page without it is a layout problem.
+	client := &HRUIClient{URL: server.URL, HttpClient: server.Client()}
+	client.setCapabilities(Capabilities{Known: true, FirmwareVersion: "V2.0", VLANMode: true})
+	_, err := client.GetVLANMode(context.Background())
+
+	var parseErr *ParseError
+	require.ErrorAs(t, err, &parseErr)
+	assert.NotErrorIs(t, err, ErrUnsupportedFeature)
+}
+
+func TestSetVLANMode(t *testing.T) {
+	var receivedForm url.Values
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			require.NoError(t, r.ParseForm())
+			receivedForm = r.PostForm
+		}
+		_, _ = w.Write([]byte(vlanModeHTML))
+	}))
+	defer server.Close()
+
+	client := withVLANMode(server)
+	err := client.SetVLANMode(context.Background(), VLANMode8021Q)
+
+	require.NoError(t, err)
+	assert.Equal(t, "0", receivedForm.Get("vlan_mode"))
+
+	assert.Error(t, client.SetVLANMode(context.Background(), VLANMode("mac_based")))
+}
+
+func TestListPortBasedVLANs(t *testing.T) {
+	server := mockServerMock(portBasedVLANHTML, http.StatusOK)
+	defer server.Close()
+
+	client := withVLANMode(server)
+	vlans, err := client.ListPortBasedVLANs(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, []*PortBasedVLAN{
+		{ID: 1, MemberPorts: []string{"Port 1", "Port 2", "Port 3", "Port 4", "Trunk1"}},
+		{ID: 2, MemberPorts: []string{"Port 5", "Port 6"}},
+	}, vlans)
+
+	vlan, err := client.GetPortBasedVLAN(context.Background(), 3)
+	assert.Nil(t, vlan)
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestSetPortBasedVLAN(t *testing.T) {
+	var receivedForm url.Values
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/port.cgi":
+			_, _ = w.Write([]byte(portBasedVLANPortsHTML))
+		case r.Method == http.MethodPost:
+			assert.Equal(t, "port_vlan", r.URL.Query().Get("page"))
+			require.NoError(t, r.ParseForm())
+			receivedForm = r.PostForm
+		}
+	}))
+	defer server.Close()
+
+	client := withVLANMode(server)
+	err := client.SetPortBasedVLAN(context.Background(), &PortBasedVLAN{ID: 3, MemberPorts: []string{"Port 1", "Port 3"}})
+
+	require.NoError(t, err)
+	assert.Equal(t, url.Values{"vid": {"3"}, "vlanPort_0": {"1"}, "vlanPort_2": {"1"}}, receivedForm)
+
+	err = client.SetPortBasedVLAN(context.Background(), &PortBasedVLAN{ID: 3, MemberPorts: []string{"Port 9"}})
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestRemovePortBasedVLAN(t *testing.T) {
+	var receivedForm url.Values
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "port_vlan_del", r.URL.Query().Get("page"))
+		require.NoError(t, r.ParseForm())
+		receivedForm = r.PostForm
+	}))
+	defer server.Close()
+
+	client := withVLANMode(server)
+	require.NoError(t, client.RemovePortBasedVLAN(context.Background(), 2))
+	assert.Equal(t, "on", receivedForm.Get("remove_2"))
+}
+
+func TestSetVLANMode_Unsupported(t *testing.T) {
+	posted := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		posted = posted || r.Method == http.MethodPost
+	}))
+	defer server.Close()
+
+	client := &HRUIClient{URL: server.URL, HttpClient: server.Client()}
+	client.setCapabilities(Capabilities{Known: true, FirmwareVersion: "V1.0"})
+	err := client.SetVLANMode(context.Background(), VLANMode8021Q)
+
+	assert.ErrorIs(t, err, ErrUnsupportedFeature)
+	assert.False(t, posted, "the mode must not be posted to firmware without the selector")
+}
@@ -0,0 +1,231 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// DefaultVLANID is the VLAN all ports belong to by default. The switch does not allow deleting it.
+const DefaultVLANID = 1
+
+// VLANTable is the complete 802.1Q VLAN configuration of the switch.
+type VLANTable struct {
+	VLANs []*Vlan
+
+	// PVIDs maps port names to their PVID. When applying a table, a nil map leaves the PVIDs
+	// unchanged; otherwise ports that are not listed are reset to DefaultVLANID.
+	PVIDs map[string]int
+}
+
+// VLANTableChanges are the requests needed to turn the current VLAN table into the desired one.
+type VLANTableChanges struct {
+	SetVLANs    []*Vlan           // VLANs to create or update
+	SetPVIDs    []*PortVLANConfig // ports whose PVID changes
+	RemoveVLANs []int             // VLANs to delete
+}
+
+// Empty reports whether the VLAN table is already as desired.
+func (ch *VLANTableChanges) Empty() bool {
+	return len(ch.SetVLANs) == 0 && len(ch.SetPVIDs) == 0 && len(ch.RemoveVLANs) == 0
+}
+
+// GetVLANTable retrieves all VLANs and the PVID of every port.
+func (c *HRUIClient) GetVLANTable(ctx context.Context) (*VLANTable, error) {
+	vlans, err := c.ListVLANs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ports, err := c.ListPortVLANConfigs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	table := &VLANTable{VLANs: vlans, PVIDs: make(map[string]int, len(ports))}
+	for _, port := range ports {
+		table.PVIDs[port.PortName] = port.PVID
+	}
+	return table, nil
+}
+
+// ApplyVLANTable makes desired the complete VLAN configuration of the switch: VLANs that differ
+// are written, PVIDs are updated and VLANs that are not listed are deleted, except DefaultVLANID.
+// Only the necessary requests are sent. It returns the changes that were applied.
+func (c *HRUIClient) ApplyVLANTable(ctx context.Context, desired *VLANTable) (*VLANTableChanges, error) {
+	var changes *VLANTableChanges
+	err := c.exclusive(ctx, func(ctx context.Context) error {
+		var err error
+		changes, err = c.applyVLANTable(ctx, desired)
+		return err
+	})
+	return changes, err
+}
+
+// applyVLANTable implements ApplyVLANTable while holding the operation lock.
+func (c *HRUIClient) applyVLANTable(ctx context.Context, desired *VLANTable) (*VLANTableChanges, error) {
+	vlans, err := c.ListVLANs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ports, err := c.ListPortVLANConfigs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	changes, err := diffVLANTable(vlans, ports, desired)
+	if err != nil {
+		return nil, err
+	}
+
+	tflog.Debug(ctx, "Applying VLAN table", map[string]any{
+		"set_vlans":    len(changes.SetVLANs),
+		"set_pvids":    len(changes.SetPVIDs),
+		"remove_vlans": len(changes.RemoveVLANs),
+	})
+
+	// VLANs are written first, so the new PVIDs refer to existing VLANs, and removed last,
+	// once no port uses them as PVID anymore.
+	for _, vlan := range changes.SetVLANs {
+		if err := c.AddVLAN(ctx, vlan); err != nil {
+			return nil, fmt.Errorf("failed to set VLAN %d: %w", vlan.VlanID, err)
+		}
+	}
+
+	for _, port := range changes.SetPVIDs {
+		if err := c.SetPortVLANConfig(ctx, port); err != nil {
+			return nil, fmt.Errorf("failed to set PVID of %s: %w", port.PortName, err)
+		}
+	}
+
+	names := make(map[int]string, len(vlans))
+	for _, vlan := range vlans {
+		names[vlan.VlanID] = vlan.Name
+	}
+	for _, vlanID := range changes.RemoveVLANs {
+		// The firmware only deletes VLANs without members.
+		if err := c.AddVLAN(ctx, &Vlan{VlanID: vlanID, Name: names[vlanID]}); err != nil {
+			return nil, fmt.Errorf("failed to clear members of VLAN %d: %w", vlanID, err)
+		}
+		if err := c.RemoveVLAN(ctx, vlanID); err != nil {
+			return nil, fmt.Errorf("failed to remove VLAN %d: %w", vlanID, err)
+		}
+	}
+
+	return changes, nil
+}
+
+// diffVLANTable computes the changes that turn the current VLANs and port settings into desired.
+func diffVLANTable(vlans []*Vlan, ports []*PortVLANConfig, desired *VLANTable) (*VLANTableChanges, error) {
+	changes := &VLANTableChanges{}
+
+	current := make(map[int]*Vlan, len(vlans))
+	for _, vlan := range vlans {
+		current[vlan.VlanID] = vlan
+	}
+
+	wanted := make(map[int]bool, len(desired.VLANs))
+	for _, vlan := range desired.VLANs {
+		if wanted[vlan.VlanID] {
+			return nil, fmt.Errorf("VLAN %d is listed more than once", vlan.VlanID)
+		}
+		wanted[vlan.VlanID] = true
+
+		if existing, ok := current[vlan.VlanID]; !ok || !sameVLAN(existing, vlan) {
+			changes.SetVLANs = append(changes.SetVLANs, vlan)
+		}
+	}
+
+	for _, vlan := range vlans {
+		if !wanted[vlan.VlanID] && vlan.VlanID != DefaultVLANID {
+			changes.RemoveVLANs = append(changes.RemoveVLANs, vlan.VlanID)
+		}
+	}
+	sort.Ints(changes.RemoveVLANs)
+
+	if desired.PVIDs != nil {
+		known := make(map[string]bool, len(ports))
+		for _, port := range ports {
+			known[port.PortName] = true
+
+			pvid, ok := desired.PVIDs[port.PortName]
+			if !ok {
+				pvid = DefaultVLANID
+			}
+			if pvid != port.PVID {
+				changes.SetPVIDs = append(changes.SetPVIDs, &PortVLANConfig{
+					PortID:          port.PortID,
+					PortName:        port.PortName,
+					PVID:            pvid,
+					AcceptFrameType: port.AcceptFrameType,
+				})
+			}
+		}
+
+		for portName := range desired.PVIDs {
+			if !known[portName] {
+				return nil, fmt.Errorf("port '%s' %w in the port VLAN settings", portName, ErrNotFound)
+			}
+		}
+	}
+
+	return changes, nil
+}
+
+// sameVLAN reports whether two VLANs have the same name and port membership.
+func sameVLAN(a, b *Vlan) bool {
+	return a.Name == b.Name && samePorts(a.UntaggedPorts, b.UntaggedPorts) && samePorts(a.TaggedPorts, b.TaggedPorts)
+}
+
+// samePorts reports whether a and b contain the same ports, in any order.
+func samePorts(a, b []string) bool {
+	a, b = slices.Clone(a), slices.Clone(b)
+	slices.Sort(a)
+	slices.Sort(b)
+	return slices.Equal(a, b)
+}
+
+// PVIDMismatch is a port that accepts untagged frames and whose PVID is not a VLAN the port is an
+// untagged member of. The switch accepts such a configuration, but untagged traffic received on the
+// port is assigned to a VLAN it cannot be sent back on.
+type PVIDMismatch struct {
+	Port string
+	PVID int
+
+	// VLANExists reports whether the PVID is an existing VLAN.
+	VLANExists bool
+}
+
+func (m PVIDMismatch) String() string {
+	if !m.VLANExists {
+		return fmt.Sprintf("port '%s' has PVID %d, but VLAN %d does not exist", m.Port, m.PVID, m.PVID)
+	}
+	return fmt.Sprintf("port '%s' has PVID %d, but is not an untagged member of VLAN %d", m.Port, m.PVID, m.PVID)
+}
+
+// PVIDMismatches returns the ports whose PVID does not match their VLAN membership, in port order.
+// Ports that only accept tagged frames never use their PVID and are skipped.
+func PVIDMismatches(vlans []*Vlan, ports []*PortVLANConfig) []PVIDMismatch {
+	byID := make(map[int]*Vlan, len(vlans))
+	for _, vlan := range vlans {
+		byID[vlan.VlanID] = vlan
+	}
+
+	var mismatches []PVIDMismatch
+	for _, port := range ports {
+		if port.AcceptFrameType == "Tag-only" || port.AcceptFrameType == "Tagged Only" {
+			continue
+		}
+
+		vlan, ok := byID[port.PVID]
+		if ok && slices.Contains(vlan.UntaggedPorts, port.PortName) {
+			continue
+		}
+		mismatches = append(mismatches, PVIDMismatch{Port: port.PortName, PVID: port.PVID, VLANExists: ok})
+	}
+	return mismatches
+}
@@ -0,0 +1,128 @@
+package sdk
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func vlanTablePorts() []*PortVLANConfig {
+	return []*PortVLANConfig{
+		{PortID: 0, PortName: "Port 1", PVID: 1, AcceptFrameType: "All"},
+		{PortID: 1, PortName: "Port 2", PVID: 20, AcceptFrameType: "Tag-only"},
+		{PortID: 2, PortName: "Port 3", PVID: 1, AcceptFrameType: "All"},
+	}
+}
+
+func TestDiffVLANTable(t *testing.T) {
+	vlans := []*Vlan{
+		{VlanID: 1, Name: "default", UntaggedPorts: []string{"Port 1", "Port 3"}},
+		{VlanID: 10, Name: "office", UntaggedPorts: []string{"Port 1"}, TaggedPorts: []string{"Port 2", "Port 3"}},
+		{VlanID: 20, Name: "voice", TaggedPorts: []string{"Port 2"}},
+		{VlanID: 30, Name: "legacy", TaggedPorts: []string{"Port 3"}},
+	}
+
+	changes, err := diffVLANTable(vlans, vlanTablePorts(), &VLANTable{
+		VLANs: []*Vlan{
+			// Same membership in another order: unchanged.
+			{VlanID: 10, Name: "office", UntaggedPorts: []string{"Port 1"}, TaggedPorts: []string{"Port 3", "Port 2"}},
+			{VlanID: 20, Name: "voip", TaggedPorts: []string{"Port 2"}},
+			{VlanID: 40, Name: "guest", UntaggedPorts: []string{"Port 3"}},
+		},
+		PVIDs: map[string]int{"Port 3": 40},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, []*Vlan{
+		{VlanID: 20, Name: "voip", TaggedPorts: []string{"Port 2"}},
+		{VlanID: 40, Name: "guest", UntaggedPorts: []string{"Port 3"}},
+	}, changes.SetVLANs)
+	assert.Equal(t, []*PortVLANConfig{
+		{PortID: 1, PortName: "Port 2", PVID: 1, AcceptFrameType: "Tag-only"},
+		{PortID: 2, PortName: "Port 3", PVID: 40, AcceptFrameType: "All"},
+	}, changes.SetPVIDs)
+	assert.Equal(t, []int{30}, changes.RemoveVLANs, "the default VLAN is never removed")
+}
+
+func TestDiffVLANTable_NoChanges(t *testing.T) {
+	vlans := []*Vlan{{VlanID: 1, Name: "default", UntaggedPorts: []string{"Port 1"}}}
+
+	changes, err := diffVLANTable(vlans, vlanTablePorts(), &VLANTable{
+		VLANs: []*Vlan{{VlanID: 1, Name: "default", UntaggedPorts: []string{"Port 1"}}},
+	})
+
+	require.NoError(t, err)
+	assert.True(t, changes.Empty(), "a nil PVID map leaves the PVIDs unchanged")
+}
+
+func TestDiffVLANTable_Invalid(t *testing.T) {
+	_, err := diffVLANTable(nil, vlanTablePorts(), &VLANTable{
+		VLANs: []*Vlan{{VlanID: 10}, {VlanID: 10}},
+	})
+	assert.ErrorContains(t, err, "VLAN 10 is listed more than once")
+
+	_, err = diffVLANTable(nil, vlanTablePorts(), &VLANTable{PVIDs: map[string]int{"Port 9": 10}})
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestApplyVLANTable(t *testing.T) {
+	var posts []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost:
+			require.NoError(t, r.ParseForm())
+			posts = append(posts, r.URL.RawQuery+" "+r.PostForm.Encode())
+		case r.URL.Path == "/port.cgi":
+			_, _ = w.Write([]byte(samplePortHTMLResponse))
+		case strings.Contains(r.URL.RawQuery, "page=port_based"):
+			_, _ = w.Write([]byte(sampleVLANPVIDHTMLResponse))
+		default:
+			_, _ = w.Write([]byte(sampleVLANHTMLResponse))
+		}
+	}))
+	defer server.Close()
+
+	client := &HRUIClient{URL: server.URL, HttpClient: server.Client()}
+	changes, err := client.ApplyVLANTable(context.Background(), &VLANTable{
+		VLANs: []*Vlan{{VlanID: 20, Name: "voice", TaggedPorts: []string{"Port 2"}}},
+		PVIDs: map[string]int{"Port 2": 20},
+	})
+
+	require.NoError(t, err)
+	assert.Len(t, changes.SetVLANs, 1)
+	assert.Equal(t, []int{10}, changes.RemoveVLANs)
+	assert.Equal(t, []string{
+		"page=static " + url.Values{"vid": {"20"}, "name": {"voice"}, "vlanPort_0": {"2"}, "vlanPort_1": {"1"}, "vlanPort_10": {"2"}}.Encode(),
+		"page=port_based " + url.Values{"ports": {"10"}, "pvid": {"1"}, "vlan_accept_frame_type": {"0"}}.Encode(),
+		"page=static " + url.Values{"vid": {"10"}, "name": {"myvlan-lala"}, "vlanPort_0": {"2"}, "vlanPort_1": {"2"}, "vlanPort_10": {"2"}}.Encode(),
+		"page=getRmvVlanEntry " + url.Values{"remove_10": {"on"}}.Encode(),
+	}, posts)
+}
+
+func TestPVIDMismatches(t *testing.T) {
+	vlans := []*Vlan{
+		{VlanID: 1, Name: "default", UntaggedPorts: []string{"Port 1"}},
+		{VlanID: 10, Name: "office", TaggedPorts: []string{"Port 3"}},
+	}
+	ports := []*PortVLANConfig{
+		{PortID: 0, PortName: "Port 1", PVID: 1, AcceptFrameType: "All"},
+		{PortID: 1, PortName: "Port 2", PVID: 20, AcceptFrameType: "Tag-only"},
+		{PortID: 2, PortName: "Port 3", PVID: 10, AcceptFrameType: "All"},
+		{PortID: 3, PortName: "Port 4", PVID: 30, AcceptFrameType: "Untag-only"},
+	}
+
+	mismatches := PVIDMismatches(vlans, ports)
+
+	assert.Equal(t, []PVIDMismatch{
+		{Port: "Port 3", PVID: 10, VLANExists: true},
+		{Port: "Port 4", PVID: 30},
+	}, mismatches, "tagged-only ports are skipped")
+	assert.Equal(t, "port 'Port 3' has PVID 10, but is not an untagged member of VLAN 10", mismatches[0].String())
+	assert.Equal(t, "port 'Port 4' has PVID 30, but VLAN 30 does not exist", mismatches[1].String())
+}
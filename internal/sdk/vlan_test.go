@@ -4,8 +4,11 @@ import (
 	"context"
 	"net/http"
 	"net/http/httptest"
+	"strconv"
 	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -184,3 +187,69 @@ func TestListVLANsWithTrunkMembers(t *testing.T) {
 	assert.NoError(t, err)
 	assert.NotEmpty(t, configs)
 }
+
+func TestAddVLAN_Concurrent(t *testing.T) {
+	// The fake keeps the VLANs posted to it and counts the AddVLAN operations between reading
+	// the port settings and posting the VLAN. Interleaved operations would overlap.
+	var (
+		mu       sync.Mutex
+		vlans    = map[int]string{}
+		open     int
+		maxOpen  int
+		requests []string
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && strings.Contains(r.URL.RawQuery, "page=port_based"):
+			mu.Lock()
+			open++
+			maxOpen = max(maxOpen, open)
+			requests = append(requests, "read")
+			mu.Unlock()
+			// Give the other operation time to get in between.
+			time.Sleep(20 * time.Millisecond)
+			_, _ = w.Write([]byte(sampleVLANPVIDHTMLResponse))
+		case r.Method == http.MethodPost:
+			require.NoError(t, r.ParseForm())
+			vid, err := strconv.Atoi(r.PostForm.Get("vid"))
+			require.NoError(t, err)
+			mu.Lock()
+			open--
+			vlans[vid] = r.PostForm.Get("name")
+			requests = append(requests, "write")
+			mu.Unlock()
+		}
+	}))
+	defer server.Close()
+
+	client := &HRUIClient{URL: server.URL, HttpClient: server.Client(), MaxConcurrentRequests: 2}
+
+	var wg sync.WaitGroup
+	for _, vlanID := range []int{10, 20} {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			assert.NoError(t, client.AddVLAN(context.Background(), &Vlan{VlanID: vlanID, Name: "vlan" + strconv.Itoa(vlanID)}))
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, 1, maxOpen, "AddVLAN operations must not interleave")
+	assert.Equal(t, []string{"read", "write", "read", "write"}, requests)
+	assert.Equal(t, map[int]string{10: "vlan10", 20: "vlan20"}, vlans)
+}
+
+func TestExclusive_Nested(t *testing.T) {
+	client := &HRUIClient{}
+
+	ran := false
+	err := client.exclusive(context.Background(), func(ctx context.Context) error {
+		return client.exclusive(ctx, func(ctx context.Context) error {
+			ran = true
+			return nil
+		})
+	})
+
+	require.NoError(t, err)
+	assert.True(t, ran, "nested operations must not deadlock")
+}